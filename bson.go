@@ -28,7 +28,13 @@ import (
 func init() {
 	var id ObjectId
 	// register this format in the default registry
-	Default.Add("bsonobjectid", &id, IsBSONObjectID)
+	AddWithInfo(Default, "bsonobjectid", &id, ValidateBSONObjectID, FormatInfo{
+		Description:     "A BSON ObjectId, as 24 hexadecimal digits",
+		Pattern:         `^[0-9a-fA-F]{24}$`,
+		MaxLength:       24,
+		Examples:        []string{"507f1f77bcf86cd799439011"},
+		InvalidExamples: []string{"507f1f77bcf86cd79943901z"},
+	})
 }
 
 // IsBSONObjectID returns true when the string is a valid BSON.ObjectId
@@ -36,6 +42,22 @@ func IsBSONObjectID(str string) bool {
 	return bson.IsObjectIdHex(str)
 }
 
+// ValidateBSONObjectID returns a *FormatError when the string is not a valid BSON.ObjectId
+func ValidateBSONObjectID(str string) error {
+	for i := 0; i < len(str) && i < 24; i++ {
+		if !isHexDigit(str[i]) {
+			return newFormatError("bsonobjectid", str, i, ReasonInvalidCharacter)
+		}
+	}
+	if len(str) < 24 {
+		return newFormatError("bsonobjectid", str, len(str), ReasonInvalidLength)
+	}
+	if len(str) > 24 {
+		return newFormatError("bsonobjectid", str, 24, ReasonInvalidLength)
+	}
+	return nil
+}
+
 // ObjectId represents a BSON object ID (alias to gopkg.in/mgo.v2/bson.ObjectId)
 //
 // swagger:strfmt bsonobjectid
@@ -52,8 +74,11 @@ func (id *ObjectId) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText hydrates this instance from text
-func (id *ObjectId) UnmarshalText(data []byte) error { // validation is performed later on
-	*id = ObjectId(bson.ObjectIdHex(string(data)))
+func (id *ObjectId) UnmarshalText(data []byte) error {
+	if err := ValidateBSONObjectID(string(data)); err != nil {
+		return err
+	}
+	*id = NewObjectId(string(data))
 	return nil
 }
 
@@ -66,7 +91,7 @@ func (id *ObjectId) Scan(raw interface{}) error {
 	case string:
 		data = []byte(v)
 	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.URI from: %#v", v)
+		return fmt.Errorf("cannot sql.Scan() strfmt.ObjectId from: %#v", v)
 	}
 
 	return id.UnmarshalText(data)
@@ -50,4 +50,8 @@ func TestBSONObjectId_fullCycle(t *testing.T) {
 	err = bson.Unmarshal(bsonBytes, &idCopy)
 	assert.NoError(t, err)
 	assert.Equal(t, id, idCopy)
+
+	err = idCopy.UnmarshalText([]byte("not-an-object-id"))
+	assert.Error(t, err)
+	assert.Equal(t, id, idCopy)
 }
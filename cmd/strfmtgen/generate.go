@@ -0,0 +1,332 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"text/template"
+)
+
+// GenerateFormats renders the format types and their registration
+func GenerateFormats(spec *Spec) ([]byte, error) {
+	return render(formatsTemplate, spec)
+}
+
+// GenerateTests renders the tests of the format types
+func GenerateTests(spec *Spec) ([]byte, error) {
+	return render(testsTemplate, spec)
+}
+
+// GenerateConv renders the conv helpers of the format types
+func GenerateConv(spec *Spec) ([]byte, error) {
+	if spec.Import == "" {
+		return nil, fmt.Errorf("the import path of package %s is required to generate conv helpers", spec.Package)
+	}
+	return render(convTemplate, spec)
+}
+
+func render(tpl *template.Template, spec *Spec) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, spec); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated invalid code: %v\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+// quote renders a string as a raw string literal when possible, to keep patterns readable
+func quote(s string) string {
+	if strconv.CanBackquote(s) {
+		return "`" + s + "`"
+	}
+	return strconv.Quote(s)
+}
+
+var formatsTemplate = template.Must(template.New("formats").Funcs(template.FuncMap{"quote": quote}).Parse(`// Code generated by strfmtgen{{ with .Source }} from {{ . }}{{ end }}. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"gopkg.in/mgo.v2/bson"
+{{ if or .Imports (not .IsStrfmt) }}
+{{ range .Imports }}	"{{ . }}"
+{{ end }}{{ if not .IsStrfmt }}	"github.com/go-openapi/strfmt"
+{{ end }}{{ end }})
+
+func init() {
+	// register formats in the registry:
+{{- range .Formats }}
+	//   - {{ .Name }}
+{{- end }}
+{{- range .Formats }}
+
+	{{ $.Qualifier }}AddWithInfo({{ $.Registry }}, "{{ .Name }}", new({{ .Type }}),
+{{- if .ValidatorE }} {{ .ValidatorE }}{{ else }} {{ $.Qualifier }}WrapValidator({{ .Validator }}){{ end }}, {{ $.Qualifier }}FormatInfo{
+{{- with .Description }}
+		Description: {{ printf "%q" . }},
+{{- end }}
+{{- with .Pattern }}
+		Pattern: {{ quote . }},
+{{- end }}
+{{- with .MaxLength }}
+		MaxLength: {{ . }},
+{{- end }}
+{{- with .Valid }}
+		Examples: []string{ {{- range $i, $v := . }}{{ if $i }}, {{ end }}{{ printf "%q" $v }}{{ end -}} },
+{{- end }}
+{{- with .Invalid }}
+		InvalidExamples: []string{ {{- range $i, $v := . }}{{ if $i }}, {{ end }}{{ printf "%q" $v }}{{ end -}} },
+{{- end }}
+	})
+{{- end }}
+}
+{{ range .Formats }}
+{{ range .DocLines }}// {{ . }}
+{{ end }}//
+// swagger:strfmt {{ .Name }}
+type {{ .Type }} string
+
+// MarshalText turns this instance into text
+func ({{ .Receiver }} {{ .Type }}) MarshalText() ([]byte, error) {
+	return []byte(string({{ .Receiver }})), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func ({{ .Receiver }} *{{ .Type }}) UnmarshalText(data []byte) error { // validation is performed later on
+	*{{ .Receiver }} = {{ .Type }}(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func ({{ .Receiver }} *{{ .Type }}) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*{{ .Receiver }} = {{ .Type }}({{ with .ScanBytes }}{{ . }}(v){{ else }}string(v){{ end }})
+	case string:
+		*{{ .Receiver }} = {{ .Type }}(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() {{ $.Package }}.{{ .Type }} from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func ({{ .Receiver }} {{ .Type }}) Value() (driver.Value, error) {
+	return driver.Value(string({{ .Receiver }})), nil
+}
+
+func ({{ .Receiver }} {{ .Type }}) String() string {
+	return string({{ .Receiver }})
+}
+
+// MarshalJSON returns the {{ .Type }} as JSON
+func ({{ .Receiver }} {{ .Type }}) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	{{ .Receiver }}.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the {{ .Type }} to a easyjson.Writer
+func ({{ .Receiver }} {{ .Type }}) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string({{ .Receiver }}))
+}
+
+// UnmarshalJSON sets the {{ .Type }} from JSON
+func ({{ .Receiver }} *{{ .Type }}) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	{{ .Receiver }}.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the {{ .Type }} from a easyjson.Lexer
+func ({{ .Receiver }} *{{ .Type }}) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*{{ .Receiver }} = {{ .Type }}(data)
+	}
+}
+
+// GetBSON returns the {{ .Type }} as a bson.M{} map.
+func ({{ .Receiver }} *{{ .Type }}) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*{{ .Receiver }})}, nil
+}
+
+// SetBSON sets the {{ .Type }} from raw bson data
+func ({{ .Receiver }} *{{ .Type }}) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*{{ .Receiver }} = {{ .Type }}(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as {{ .Type }}")
+}
+{{- if .UUID }}
+
+// Bytes returns the 16 bytes of the UUID, all zero when it isn't a valid UUID
+func ({{ .Receiver }} {{ .Type }}) Bytes() [16]byte {
+	b, _ := uuidBytes(string({{ .Receiver }}))
+	return b
+}
+
+// Canonical returns the UUID in lower case with dashes, unchanged when it isn't a valid UUID
+func ({{ .Receiver }} {{ .Type }}) Canonical() {{ .Type }} {
+	return {{ .Type }}(canonicalUUID(string({{ .Receiver }})))
+}
+
+// Version returns the version of the UUID, from the first digit of its third group
+func ({{ .Receiver }} {{ .Type }}) Version() int {
+	return uuidVersion(string({{ .Receiver }}))
+}
+
+// Variant returns the variant of the UUID, from the first digit of its fourth group
+func ({{ .Receiver }} {{ .Type }}) Variant() UUIDVariant {
+	return uuidVariant(string({{ .Receiver }}))
+}
+
+func ({{ .Receiver }} *{{ .Type }}) canonicalize() {
+	*{{ .Receiver }} = {{ .Receiver }}.Canonical()
+}
+{{- end }}
+{{ end }}`))
+
+var testsTemplate = template.Must(template.New("tests").Funcs(template.FuncMap{"quote": quote}).Parse(`// Code generated by strfmtgen{{ with .Source }} from {{ . }}{{ end }}. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+{{- if .HasPatterns }}
+	"regexp"
+{{- end }}
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+{{ if not .IsStrfmt }}
+	"github.com/go-openapi/strfmt"
+{{ end }})
+{{ range .Formats }}
+func TestGenerated{{ .Type }}(t *testing.T) {
+	for _, str := range []string{ {{- range $i, $v := .Valid }}{{ if $i }}, {{ end }}{{ printf "%q" $v }}{{ end -}} } {
+		var v {{ .Type }}
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy {{ .Type }}
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy {{ .Type }}
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy {{ .Type }}
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !{{ $.Registry }}.Validates("{{ .Name }}", str) {
+			t.Errorf("expected %q to be a valid {{ .Name }}", str)
+		}
+		{{- if .Pattern }}
+		if !regexp.MustCompile({{ quote .Pattern }}).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of {{ .Name }}", str)
+		}
+		{{- end }}
+		{{- if .MaxLength }}
+		if len(str) > {{ .MaxLength }} {
+			t.Errorf("expected %q to be at most {{ .MaxLength }} bytes long", str)
+		}
+		{{- end }}
+		{{- if .UUID }}
+		if c := v.Canonical(); c.Bytes() != v.Bytes() || !{{ $.Registry }}.Validates("{{ .Name }}", c.String()) {
+			t.Errorf("Canonical() = %q, expected the same valid {{ .Name }} as %q", c, str)
+		}
+		{{- end }}
+	}
+
+	for _, str := range []string{ {{- range $i, $v := .Invalid }}{{ if $i }}, {{ end }}{{ printf "%q" $v }}{{ end -}} } {
+		if {{ $.Registry }}.Validates("{{ .Name }}", str) {
+			t.Errorf("expected %q to be an invalid {{ .Name }}", str)
+		}
+	}
+
+	var v {{ .Type }}
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+{{ end }}`))
+
+var convTemplate = template.Must(template.New("conv").Parse(`// Code generated by strfmtgen{{ with .Source }} from {{ . }}{{ end }}. DO NOT EDIT.
+
+package {{ .Conv }}
+
+import {{ if .NeedsAlias }}{{ .Package }} {{ end }}"{{ .Import }}"
+{{ range .Formats }}
+// {{ .Type }} returns a pointer to of the {{ .Type }} value passed in.
+func {{ .Type }}(v {{ $.Package }}.{{ .Type }}) *{{ $.Package }}.{{ .Type }} {
+	return &v
+}
+
+// {{ .Type }}Value returns the value of the {{ .Type }} pointer passed in or
+// the default value if the pointer is nil.
+func {{ .Type }}Value(v *{{ $.Package }}.{{ .Type }}) {{ $.Package }}.{{ .Type }} {
+	if v == nil {
+		return {{ $.Package }}.{{ .Type }}("")
+	}
+
+	return *v
+}
+{{ end }}`))
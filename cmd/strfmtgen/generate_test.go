@@ -0,0 +1,161 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGenerate_upToDate checks the generated files of the strfmt package are in sync with their spec
+func TestGenerate_upToDate(t *testing.T) {
+	root := filepath.Join("..", "..")
+	spec, err := LoadSpec(filepath.Join(root, "default_formats.json"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	spec.Source = "default_formats.json"
+
+	targets := map[string]func(*Spec) ([]byte, error){
+		"default_gen.go":                        GenerateFormats,
+		"default_gen_test.go":                   GenerateTests,
+		filepath.Join("conv", "default_gen.go"): GenerateConv,
+	}
+	for file, generate := range targets {
+		expected, err := generate(spec)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		actual, err := ioutil.ReadFile(filepath.Join(root, file))
+		if assert.NoError(t, err) {
+			assert.Equal(t, string(expected), string(actual), "%s is out of date, run go generate", file)
+		}
+	}
+}
+
+func testSpec() *Spec {
+	spec := &Spec{
+		Package: "myformats",
+		Import:  "example.com/formats",
+		Imports: []string{"example.com/validators"},
+		Formats: []FormatSpec{
+			{
+				Name:        "iban",
+				Type:        "IBAN",
+				Validator:   "validators.IsIBAN",
+				Description: "An international bank account number",
+				Pattern:     `^[A-Z]{2}[0-9]{2}[A-Z0-9]{1,30}$`,
+				MaxLength:   34,
+				Valid:       []string{"DE89370400440532013000"},
+				Invalid:     []string{"DE00", `"quoted"`},
+			},
+			{
+				Name:       "bic",
+				Type:       "BIC",
+				Receiver:   "code",
+				Doc:        "BIC is a bank identifier code.\nSee ISO 9362.",
+				ValidatorE: "validators.ValidateBIC",
+				ScanBytes:  "validators.BICText",
+			},
+		},
+	}
+	if err := spec.prepare(); err != nil {
+		panic(err)
+	}
+	return spec
+}
+
+func TestGenerateFormats(t *testing.T) {
+	src, err := GenerateFormats(testSpec())
+	if !assert.NoError(t, err) {
+		return
+	}
+	code := string(src)
+	assert.Contains(t, code, "// Code generated by strfmtgen. DO NOT EDIT.\n\npackage myformats\n")
+	assert.Contains(t, code, `"example.com/validators"`)
+	assert.Contains(t, code, `"github.com/go-openapi/strfmt"`)
+	assert.Contains(t, code, `strfmt.AddWithInfo(strfmt.Default, "iban", new(IBAN), strfmt.WrapValidator(validators.IsIBAN), strfmt.FormatInfo{`)
+	assert.Contains(t, code, "Description:     \"An international bank account number\",\n")
+	assert.Contains(t, code, "Pattern:         `^[A-Z]{2}[0-9]{2}[A-Z0-9]{1,30}$`,\n")
+	assert.Contains(t, code, "MaxLength:       34,\n")
+	assert.Contains(t, code, `InvalidExamples: []string{"DE00", "\"quoted\""},`)
+	assert.Contains(t, code, `strfmt.AddWithInfo(strfmt.Default, "bic", new(BIC), validators.ValidateBIC, strfmt.FormatInfo{})`)
+	assert.Contains(t, code, "// IBAN represents a iban string format\n//\n// swagger:strfmt iban\ntype IBAN string\n")
+	assert.Contains(t, code, "// BIC is a bank identifier code.\n// See ISO 9362.\n//\n// swagger:strfmt bic\ntype BIC string\n")
+	assert.Contains(t, code, "func (i *IBAN) Scan(raw interface{}) error {")
+	assert.Contains(t, code, `cannot sql.Scan() myformats.IBAN from: %#v`)
+	assert.Contains(t, code, "\t\t*i = IBAN(string(v))\n")
+	assert.Contains(t, code, "\t\t*code = BIC(validators.BICText(v))\n")
+	assert.Contains(t, code, "func (code BIC) MarshalEasyJSON(w *jwriter.Writer) {")
+	assert.Contains(t, code, `couldn't unmarshal bson raw value as BIC`)
+}
+
+func TestGenerateFormats_uuid(t *testing.T) {
+	spec := &Spec{Package: "strfmt", Formats: []FormatSpec{{Name: "uuid", Type: "UUID", ValidatorE: "ValidateUUID", UUID: true}}}
+	if !assert.NoError(t, spec.prepare()) {
+		return
+	}
+	src, err := GenerateFormats(spec)
+	if !assert.NoError(t, err) {
+		return
+	}
+	code := string(src)
+	assert.Contains(t, code, "func (u UUID) Bytes() [16]byte {")
+	assert.Contains(t, code, "\treturn UUID(canonicalUUID(string(u)))\n")
+	assert.Contains(t, code, "func (u UUID) Version() int {")
+	assert.Contains(t, code, "func (u UUID) Variant() UUIDVariant {")
+	assert.Contains(t, code, "func (u *UUID) canonicalize() {")
+
+	src, err = GenerateFormats(testSpec())
+	if assert.NoError(t, err) {
+		assert.NotContains(t, string(src), "canonicalize")
+	}
+}
+
+func TestGenerateTests(t *testing.T) {
+	src, err := GenerateTests(testSpec())
+	if !assert.NoError(t, err) {
+		return
+	}
+	code := string(src)
+	assert.Contains(t, code, "package myformats\n")
+	assert.Contains(t, code, "func TestGeneratedIBAN(t *testing.T) {")
+	assert.Contains(t, code, `[]string{"DE89370400440532013000"}`)
+	assert.Contains(t, code, `[]string{"DE00", "\"quoted\""}`)
+	assert.Contains(t, code, `if !strfmt.Default.Validates("iban", str) {`)
+	assert.Contains(t, code, "\t\"regexp\"\n")
+	assert.Contains(t, code, "regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{1,30}$`).MatchString(str)")
+	assert.Contains(t, code, "if len(str) > 34 {")
+	assert.Contains(t, code, "func TestGeneratedBIC(t *testing.T) {")
+}
+
+func TestGenerateConv(t *testing.T) {
+	spec := testSpec()
+	src, err := GenerateConv(spec)
+	if !assert.NoError(t, err) {
+		return
+	}
+	code := string(src)
+	assert.Contains(t, code, "package conv\n\nimport myformats \"example.com/formats\"\n")
+	assert.Contains(t, code, "func IBAN(v myformats.IBAN) *myformats.IBAN {")
+	assert.Contains(t, code, "func BICValue(v *myformats.BIC) myformats.BIC {")
+
+	spec.Import = ""
+	_, err = GenerateConv(spec)
+	assert.Error(t, err)
+}
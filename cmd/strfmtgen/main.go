@@ -0,0 +1,102 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command strfmtgen generates string format types from a declarative list.
+//
+// For each format of the list, it emits a string type with the same methods as
+// the built-in strfmt string formats (text, JSON, easyjson, SQL and BSON
+// marshaling) and registers it with its validator. It can also emit tests for
+// these types and the pointer helpers found in the conv package.
+//
+// Usage:
+//
+//	//go:generate go run github.com/go-openapi/strfmt/cmd/strfmtgen -spec formats.json -output formats_gen.go -test-output formats_gen_test.go
+//
+// The list is a JSON document such as:
+//
+//	{
+//	  "package": "myformats",
+//	  "import": "example.com/myformats",
+//	  "imports": ["example.com/validators"],
+//	  "formats": [
+//	    {
+//	      "name": "iban",
+//	      "type": "IBAN",
+//	      "doc": "IBAN represents an international bank account number",
+//	      "validator": "validators.IsIBAN",
+//	      "description": "An international bank account number",
+//	      "pattern": "^[A-Z]{2}[0-9]{2}[A-Z0-9]{1,30}$",
+//	      "maxLength": 34,
+//	      "valid": ["DE89370400440532013000"],
+//	      "invalid": ["DE00"]
+//	    }
+//	  ]
+//	}
+//
+// The validator is a Go expression of type strfmt.Validator; use validatorE
+// instead for an expression of type strfmt.ValidatorE. The description, pattern,
+// maxLength and the valid and invalid examples are registered with the format
+// and returned by the Describe and List methods of the registry.
+//
+// The optional scanBytes is a Go expression of type func([]byte) string which
+// converts the bytes read from a database by the Scan method to text, for
+// formats with a binary representation such as UUIDs.
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+)
+
+func main() {
+	specFile := flag.String("spec", "", "JSON file listing the formats to generate")
+	output := flag.String("output", "", "file to write the format types to")
+	testOutput := flag.String("test-output", "", "file to write the tests of the format types to (optional)")
+	convOutput := flag.String("conv-output", "", "file to write the conv helpers to (optional)")
+	flag.Parse()
+
+	if *specFile == "" || *output == "" {
+		flag.Usage()
+		log.Fatal("strfmtgen: -spec and -output are required")
+	}
+
+	spec, err := LoadSpec(*specFile)
+	if err != nil {
+		log.Fatalf("strfmtgen: %v", err)
+	}
+	spec.Source = filepath.Base(*specFile)
+
+	targets := []struct {
+		file     string
+		generate func(*Spec) ([]byte, error)
+	}{
+		{*output, GenerateFormats},
+		{*testOutput, GenerateTests},
+		{*convOutput, GenerateConv},
+	}
+	for _, target := range targets {
+		if target.file == "" {
+			continue
+		}
+		src, err := target.generate(spec)
+		if err != nil {
+			log.Fatalf("strfmtgen: %s: %v", target.file, err)
+		}
+		if err := ioutil.WriteFile(target.file, src, 0644); err != nil {
+			log.Fatalf("strfmtgen: %v", err)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"io/ioutil"
+	"path"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const strfmtImport = "github.com/go-openapi/strfmt"
+
+// Spec is the declarative list of formats to generate
+type Spec struct {
+	// Package is the name of the package the format types are generated in
+	Package string `json:"package"`
+	// Import is the import path of this package, required to generate conv helpers
+	Import string `json:"import"`
+	// Registry is the registry the formats are added to, defaults to the strfmt default registry
+	Registry string `json:"registry"`
+	// Imports are the extra packages used by the validators
+	Imports []string `json:"imports"`
+	// Conv is the name of the package the conv helpers are generated in, defaults to "conv"
+	Conv string `json:"conv"`
+	// Formats are the formats to generate
+	Formats []FormatSpec `json:"formats"`
+
+	// Source is the name of the spec file, mentioned in the generated files
+	Source string `json:"-"`
+}
+
+// FormatSpec describes a string format to generate
+type FormatSpec struct {
+	// Name is the name the format is registered with
+	Name string `json:"name"`
+	// Type is the name of the generated Go type
+	Type string `json:"type"`
+	// Receiver is the name of the receiver of the generated methods, defaults to the lowercased first letter of the type
+	Receiver string `json:"receiver"`
+	// Doc is the doc comment of the type, defaults to "<Type> represents a <name> string format"
+	Doc string `json:"doc"`
+	// Validator is a Go expression of type strfmt.Validator
+	Validator string `json:"validator"`
+	// ValidatorE is a Go expression of type strfmt.ValidatorE
+	ValidatorE string `json:"validatorE"`
+	// Description is the human readable description of the format registered with it
+	Description string `json:"description"`
+	// Pattern is an ECMA-262 regular expression matching valid values
+	Pattern string `json:"pattern"`
+	// MaxLength is the maximum length of a valid value
+	MaxLength int `json:"maxLength"`
+	// Valid are examples of valid values, registered with the format and used in the generated tests
+	Valid []string `json:"valid"`
+	// Invalid are examples of invalid values, registered with the format and used in the generated tests
+	Invalid []string `json:"invalid"`
+	// ScanBytes is a Go expression of type func([]byte) string converting the bytes
+	// read from a database driver to text, e.g. from a binary representation.
+	// Bytes are read as text when empty.
+	ScanBytes string `json:"scanBytes"`
+	// UUID generates the Bytes, Canonical, Version and Variant methods of a UUID.
+	// The methods use helpers of the strfmt package, so they can only be
+	// generated in it.
+	UUID bool `json:"uuid"`
+}
+
+// LoadSpec reads a spec from a JSON file and checks it
+func LoadSpec(pth string) (*Spec, error) {
+	b, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return nil, err
+	}
+	var spec Spec
+	if err := json.Unmarshal(b, &spec); err != nil {
+		return nil, fmt.Errorf("%s: %v", pth, err)
+	}
+	if err := spec.prepare(); err != nil {
+		return nil, fmt.Errorf("%s: %v", pth, err)
+	}
+	return &spec, nil
+}
+
+// IsStrfmt is true when the formats are generated in the strfmt package itself
+func (s *Spec) IsStrfmt() bool {
+	return s.Import == strfmtImport
+}
+
+// Qualifier prefixes the identifiers of the strfmt package in the generated formats
+func (s *Spec) Qualifier() string {
+	if s.IsStrfmt() {
+		return ""
+	}
+	return "strfmt."
+}
+
+// HasPatterns is true when at least one of the formats has a pattern
+func (s *Spec) HasPatterns() bool {
+	for _, f := range s.Formats {
+		if f.Pattern != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsAlias is true when the package name differs from the last element of its import path
+func (s *Spec) NeedsAlias() bool {
+	return path.Base(s.Import) != s.Package
+}
+
+func (s *Spec) prepare() error {
+	if !token.IsIdentifier(s.Package) {
+		return fmt.Errorf("invalid package name %q", s.Package)
+	}
+	if s.Package == "strfmt" && s.Import == "" {
+		s.Import = strfmtImport
+	}
+	if s.Registry == "" {
+		s.Registry = s.Qualifier() + "Default"
+	}
+	if s.Conv == "" {
+		s.Conv = "conv"
+	}
+
+	seen := make(map[string]bool, len(s.Formats))
+	for i := range s.Formats {
+		f := &s.Formats[i]
+		if f.Name == "" {
+			return fmt.Errorf("format #%d has no name", i)
+		}
+		if !token.IsIdentifier(f.Type) || !token.IsExported(f.Type) {
+			return fmt.Errorf("format %s: invalid type name %q", f.Name, f.Type)
+		}
+		if seen[f.Type] {
+			return fmt.Errorf("format %s: type %s is generated twice", f.Name, f.Type)
+		}
+		seen[f.Type] = true
+		if f.UUID && !s.IsStrfmt() {
+			return fmt.Errorf("format %s: uuid methods can only be generated in package strfmt", f.Name)
+		}
+		if f.MaxLength < 0 {
+			return fmt.Errorf("format %s: invalid max length %d", f.Name, f.MaxLength)
+		}
+		if (f.Validator == "") == (f.ValidatorE == "") {
+			return fmt.Errorf("format %s: exactly one of validator and validatorE is required", f.Name)
+		}
+		if f.Receiver == "" {
+			r, _ := utf8.DecodeRuneInString(f.Type)
+			f.Receiver = string(unicode.ToLower(r))
+		}
+		if f.Doc == "" {
+			f.Doc = fmt.Sprintf("%s represents a %s string format", f.Type, f.Name)
+		}
+	}
+	return nil
+}
+
+// DocLines are the lines of the doc comment of the type
+func (f FormatSpec) DocLines() []string {
+	return strings.Split(strings.TrimSpace(f.Doc), "\n")
+}
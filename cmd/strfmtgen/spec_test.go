@@ -0,0 +1,77 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_prepare(t *testing.T) {
+	spec := &Spec{Package: "strfmt", Formats: []FormatSpec{{Name: "uri", Type: "URI", Validator: "isURI"}}}
+	if assert.NoError(t, spec.prepare()) {
+		assert.True(t, spec.IsStrfmt())
+		assert.False(t, spec.NeedsAlias())
+		assert.Equal(t, "Default", spec.Registry)
+		assert.Equal(t, "conv", spec.Conv)
+		assert.Equal(t, "u", spec.Formats[0].Receiver)
+		assert.Equal(t, "URI represents a uri string format", spec.Formats[0].Doc)
+	}
+
+	invalid := []*Spec{
+		{Package: "my-formats"},
+		{Package: "f", Formats: []FormatSpec{{Type: "URI", Validator: "v"}}},
+		{Package: "f", Formats: []FormatSpec{{Name: "uri", Type: "uri", Validator: "v"}}},
+		{Package: "f", Formats: []FormatSpec{{Name: "uri", Type: "URI"}}},
+		{Package: "f", Formats: []FormatSpec{{Name: "uri", Type: "URI", Validator: "v", ValidatorE: "v"}}},
+		{Package: "f", Formats: []FormatSpec{{Name: "uri", Type: "URI", Validator: "v"}, {Name: "url", Type: "URI", Validator: "v"}}},
+		{Package: "f", Formats: []FormatSpec{{Name: "uri", Type: "URI", Validator: "v", MaxLength: -1}}},
+		{Package: "f", Formats: []FormatSpec{{Name: "uuid", Type: "UUID", Validator: "v", UUID: true}}},
+	}
+	for _, spec := range invalid {
+		assert.Error(t, spec.prepare(), "expected an error for %#v", spec)
+	}
+}
+
+func TestLoadSpec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "strfmtgen")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer os.RemoveAll(dir)
+
+	pth := filepath.Join(dir, "formats.json")
+	_, err = LoadSpec(pth)
+	assert.Error(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(pth, []byte(`{"package": "f", "formats": [{"name": "x"`), 0644))
+	_, err = LoadSpec(pth)
+	assert.Error(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(pth, []byte(`{"package": "f", "formats": [{"name": "x", "type": "X"}]}`), 0644))
+	_, err = LoadSpec(pth)
+	assert.Error(t, err)
+
+	assert.NoError(t, ioutil.WriteFile(pth, []byte(`{"package": "f", "formats": [{"name": "x", "type": "X", "validator": "isX"}]}`), 0644))
+	spec, err := LoadSpec(pth)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "strfmt.Default", spec.Registry)
+		assert.Len(t, spec.Formats, 1)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// conformanceFixtures are the format fixtures of the JSON-Schema-Test-Suite
+var conformanceFixtures = filepath.Join("testdata", "JSON-Schema-Test-Suite", "tests", "draft2020-12", "optional", "format", "*.json")
+
+// knownDeviations are the test cases of the JSON-Schema-Test-Suite where the
+// strfmt validators deliberately differ from the JSON schema specification,
+// indexed by format and description of the test case.
+//
+// A deviation which is not observed anymore fails the conformance test, so
+// that this list is kept up to date.
+var knownDeviations = map[string]map[string]string{
+	"date-time": {
+		"a valid date-time with a leap second, UTC":               "a date-time is a time.Time, which has no leap second",
+		"a valid date-time with a leap second, with minus offset": "a date-time is a time.Time, which has no leap second",
+	},
+	"email": {
+		"a quoted string with a space in the local part is valid":      "email is validated by govalidator.IsEmail, which rejects quoted local parts",
+		"a quoted string with a double dot in the local part is valid": "email is validated by govalidator.IsEmail, which rejects quoted local parts",
+		"a quoted string with a @ in the local part is valid":          "email is validated by govalidator.IsEmail, which rejects quoted local parts",
+		"an IPv4-address-literal after the @ is valid":                 "email is validated by govalidator.IsEmail, which rejects address literals",
+		"an IPv6-address-literal after the @ is valid":                 "email is validated by govalidator.IsEmail, which rejects address literals",
+	},
+	"hostname": {
+		"single label starting with digit": "hostname labels start with a letter, as in RFC 952",
+	},
+	"uri": {
+		"an invalid protocol-relative URI Reference": "uri accepts request URIs, which may be relative",
+		"an invalid relative URI Reference":          "uri accepts request URIs, which may be relative",
+	},
+	"uuid": {
+		"no dashes":      "the dashes of a uuid are optional",
+		"too few dashes": "the dashes of a uuid are optional",
+	},
+}
+
+type conformanceGroup struct {
+	Description string `json:"description"`
+	Schema      struct {
+		Format string `json:"format"`
+	} `json:"schema"`
+	Tests []struct {
+		Description string      `json:"description"`
+		Data        interface{} `json:"data"`
+		Valid       bool        `json:"valid"`
+	} `json:"tests"`
+}
+
+func loadConformanceGroups(t testing.TB) []conformanceGroup {
+	files, err := filepath.Glob(conformanceFixtures)
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no conformance fixture found in %s: %v", conformanceFixtures, err)
+	}
+
+	var groups []conformanceGroup
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var fileGroups []conformanceGroup
+		if err := json.Unmarshal(b, &fileGroups); err != nil {
+			t.Fatalf("%s: %v", file, err)
+		}
+		groups = append(groups, fileGroups...)
+	}
+	return groups
+}
+
+// TestConformance runs the formats of the default registry against the
+// format fixtures of the JSON-Schema-Test-Suite
+func TestConformance(t *testing.T) {
+	type score struct{ passed, total int }
+	scores := make(map[string]*score)
+	observed := make(map[string]map[string]bool)
+
+	for _, group := range loadConformanceGroups(t) {
+		format := group.Schema.Format
+		if !assert.True(t, Default.ContainsName(format), "format %s of %q is not registered", format, group.Description) {
+			continue
+		}
+		if scores[format] == nil {
+			scores[format] = &score{}
+			observed[format] = make(map[string]bool)
+		}
+
+		for _, test := range group.Tests {
+			str, ok := test.Data.(string)
+			if !ok {
+				// formats only apply to strings
+				continue
+			}
+			scores[format].total++
+			if Default.Validates(format, str) == test.Valid {
+				scores[format].passed++
+				continue
+			}
+			if reason, ok := knownDeviations[format][test.Description]; ok {
+				observed[format][test.Description] = true
+				t.Logf("%s: %q (%s): known deviation: %s", format, str, test.Description, reason)
+				continue
+			}
+			if test.Valid {
+				t.Errorf("%s: expected %q to be valid (%s)", format, str, test.Description)
+			} else {
+				t.Errorf("%s: expected %q to be invalid (%s)", format, str, test.Description)
+			}
+		}
+	}
+
+	for format, deviations := range knownDeviations {
+		for description := range deviations {
+			assert.True(t, observed[format][description], "%s: %q is not a deviation anymore, remove it from the known deviations", format, description)
+		}
+	}
+
+	formats := make([]string, 0, len(scores))
+	for format := range scores {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+	for _, format := range formats {
+		s := scores[format]
+		t.Logf("%s: %d/%d test cases conform", format, s.passed, s.total)
+	}
+}
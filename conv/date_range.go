@@ -0,0 +1,33 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// DateRange returns a pointer to of the DateRange value passed in.
+func DateRange(v strfmt.DateRange) *strfmt.DateRange {
+	return &v
+}
+
+// DateRangeValue returns the value of the DateRange pointer passed in or
+// the default value if the pointer is nil.
+func DateRangeValue(v *strfmt.DateRange) strfmt.DateRange {
+	if v == nil {
+		return strfmt.DateRange{}
+	}
+
+	return *v
+}
+
+// DateTimeRange returns a pointer to of the DateTimeRange value passed in.
+func DateTimeRange(v strfmt.DateTimeRange) *strfmt.DateTimeRange {
+	return &v
+}
+
+// DateTimeRangeValue returns the value of the DateTimeRange pointer passed in or
+// the default value if the pointer is nil.
+func DateTimeRangeValue(v *strfmt.DateTimeRange) strfmt.DateTimeRange {
+	if v == nil {
+		return strfmt.DateTimeRange{}
+	}
+
+	return *v
+}
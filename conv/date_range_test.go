@@ -0,0 +1,21 @@
+package conv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateRangeValue(t *testing.T) {
+	assert.Equal(t, strfmt.DateRange{}, DateRangeValue(nil))
+	r := strfmt.DateRange{Start: strfmt.NewDate(2024, time.January, 1)}
+	assert.Equal(t, r, DateRangeValue(&r))
+}
+
+func TestDateTimeRangeValue(t *testing.T) {
+	assert.Equal(t, strfmt.DateTimeRange{}, DateTimeRangeValue(nil))
+	r := strfmt.DateTimeRange{Start: strfmt.DateTime(time.Now())}
+	assert.Equal(t, r, DateTimeRangeValue(&r))
+}
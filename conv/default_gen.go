@@ -0,0 +1,485 @@
+// Code generated by strfmtgen from default_formats.json. DO NOT EDIT.
+
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// URI returns a pointer to of the URI value passed in.
+func URI(v strfmt.URI) *strfmt.URI {
+	return &v
+}
+
+// URIValue returns the value of the URI pointer passed in or
+// the default value if the pointer is nil.
+func URIValue(v *strfmt.URI) strfmt.URI {
+	if v == nil {
+		return strfmt.URI("")
+	}
+
+	return *v
+}
+
+// Email returns a pointer to of the Email value passed in.
+func Email(v strfmt.Email) *strfmt.Email {
+	return &v
+}
+
+// EmailValue returns the value of the Email pointer passed in or
+// the default value if the pointer is nil.
+func EmailValue(v *strfmt.Email) strfmt.Email {
+	if v == nil {
+		return strfmt.Email("")
+	}
+
+	return *v
+}
+
+// Hostname returns a pointer to of the Hostname value passed in.
+func Hostname(v strfmt.Hostname) *strfmt.Hostname {
+	return &v
+}
+
+// HostnameValue returns the value of the Hostname pointer passed in or
+// the default value if the pointer is nil.
+func HostnameValue(v *strfmt.Hostname) strfmt.Hostname {
+	if v == nil {
+		return strfmt.Hostname("")
+	}
+
+	return *v
+}
+
+// IPv4 returns a pointer to of the IPv4 value passed in.
+func IPv4(v strfmt.IPv4) *strfmt.IPv4 {
+	return &v
+}
+
+// IPv4Value returns the value of the IPv4 pointer passed in or
+// the default value if the pointer is nil.
+func IPv4Value(v *strfmt.IPv4) strfmt.IPv4 {
+	if v == nil {
+		return strfmt.IPv4("")
+	}
+
+	return *v
+}
+
+// IPv6 returns a pointer to of the IPv6 value passed in.
+func IPv6(v strfmt.IPv6) *strfmt.IPv6 {
+	return &v
+}
+
+// IPv6Value returns the value of the IPv6 pointer passed in or
+// the default value if the pointer is nil.
+func IPv6Value(v *strfmt.IPv6) strfmt.IPv6 {
+	if v == nil {
+		return strfmt.IPv6("")
+	}
+
+	return *v
+}
+
+// MAC returns a pointer to of the MAC value passed in.
+func MAC(v strfmt.MAC) *strfmt.MAC {
+	return &v
+}
+
+// MACValue returns the value of the MAC pointer passed in or
+// the default value if the pointer is nil.
+func MACValue(v *strfmt.MAC) strfmt.MAC {
+	if v == nil {
+		return strfmt.MAC("")
+	}
+
+	return *v
+}
+
+// UUID returns a pointer to of the UUID value passed in.
+func UUID(v strfmt.UUID) *strfmt.UUID {
+	return &v
+}
+
+// UUIDValue returns the value of the UUID pointer passed in or
+// the default value if the pointer is nil.
+func UUIDValue(v *strfmt.UUID) strfmt.UUID {
+	if v == nil {
+		return strfmt.UUID("")
+	}
+
+	return *v
+}
+
+// UUID3 returns a pointer to of the UUID3 value passed in.
+func UUID3(v strfmt.UUID3) *strfmt.UUID3 {
+	return &v
+}
+
+// UUID3Value returns the value of the UUID3 pointer passed in or
+// the default value if the pointer is nil.
+func UUID3Value(v *strfmt.UUID3) strfmt.UUID3 {
+	if v == nil {
+		return strfmt.UUID3("")
+	}
+
+	return *v
+}
+
+// UUID4 returns a pointer to of the UUID4 value passed in.
+func UUID4(v strfmt.UUID4) *strfmt.UUID4 {
+	return &v
+}
+
+// UUID4Value returns the value of the UUID4 pointer passed in or
+// the default value if the pointer is nil.
+func UUID4Value(v *strfmt.UUID4) strfmt.UUID4 {
+	if v == nil {
+		return strfmt.UUID4("")
+	}
+
+	return *v
+}
+
+// UUID5 returns a pointer to of the UUID5 value passed in.
+func UUID5(v strfmt.UUID5) *strfmt.UUID5 {
+	return &v
+}
+
+// UUID5Value returns the value of the UUID5 pointer passed in or
+// the default value if the pointer is nil.
+func UUID5Value(v *strfmt.UUID5) strfmt.UUID5 {
+	if v == nil {
+		return strfmt.UUID5("")
+	}
+
+	return *v
+}
+
+// UUID6 returns a pointer to of the UUID6 value passed in.
+func UUID6(v strfmt.UUID6) *strfmt.UUID6 {
+	return &v
+}
+
+// UUID6Value returns the value of the UUID6 pointer passed in or
+// the default value if the pointer is nil.
+func UUID6Value(v *strfmt.UUID6) strfmt.UUID6 {
+	if v == nil {
+		return strfmt.UUID6("")
+	}
+
+	return *v
+}
+
+// UUID7 returns a pointer to of the UUID7 value passed in.
+func UUID7(v strfmt.UUID7) *strfmt.UUID7 {
+	return &v
+}
+
+// UUID7Value returns the value of the UUID7 pointer passed in or
+// the default value if the pointer is nil.
+func UUID7Value(v *strfmt.UUID7) strfmt.UUID7 {
+	if v == nil {
+		return strfmt.UUID7("")
+	}
+
+	return *v
+}
+
+// UUID8 returns a pointer to of the UUID8 value passed in.
+func UUID8(v strfmt.UUID8) *strfmt.UUID8 {
+	return &v
+}
+
+// UUID8Value returns the value of the UUID8 pointer passed in or
+// the default value if the pointer is nil.
+func UUID8Value(v *strfmt.UUID8) strfmt.UUID8 {
+	if v == nil {
+		return strfmt.UUID8("")
+	}
+
+	return *v
+}
+
+// ULID returns a pointer to of the ULID value passed in.
+func ULID(v strfmt.ULID) *strfmt.ULID {
+	return &v
+}
+
+// ULIDValue returns the value of the ULID pointer passed in or
+// the default value if the pointer is nil.
+func ULIDValue(v *strfmt.ULID) strfmt.ULID {
+	if v == nil {
+		return strfmt.ULID("")
+	}
+
+	return *v
+}
+
+// KSUID returns a pointer to of the KSUID value passed in.
+func KSUID(v strfmt.KSUID) *strfmt.KSUID {
+	return &v
+}
+
+// KSUIDValue returns the value of the KSUID pointer passed in or
+// the default value if the pointer is nil.
+func KSUIDValue(v *strfmt.KSUID) strfmt.KSUID {
+	if v == nil {
+		return strfmt.KSUID("")
+	}
+
+	return *v
+}
+
+// ISBN returns a pointer to of the ISBN value passed in.
+func ISBN(v strfmt.ISBN) *strfmt.ISBN {
+	return &v
+}
+
+// ISBNValue returns the value of the ISBN pointer passed in or
+// the default value if the pointer is nil.
+func ISBNValue(v *strfmt.ISBN) strfmt.ISBN {
+	if v == nil {
+		return strfmt.ISBN("")
+	}
+
+	return *v
+}
+
+// ISBN10 returns a pointer to of the ISBN10 value passed in.
+func ISBN10(v strfmt.ISBN10) *strfmt.ISBN10 {
+	return &v
+}
+
+// ISBN10Value returns the value of the ISBN10 pointer passed in or
+// the default value if the pointer is nil.
+func ISBN10Value(v *strfmt.ISBN10) strfmt.ISBN10 {
+	if v == nil {
+		return strfmt.ISBN10("")
+	}
+
+	return *v
+}
+
+// ISBN13 returns a pointer to of the ISBN13 value passed in.
+func ISBN13(v strfmt.ISBN13) *strfmt.ISBN13 {
+	return &v
+}
+
+// ISBN13Value returns the value of the ISBN13 pointer passed in or
+// the default value if the pointer is nil.
+func ISBN13Value(v *strfmt.ISBN13) strfmt.ISBN13 {
+	if v == nil {
+		return strfmt.ISBN13("")
+	}
+
+	return *v
+}
+
+// CreditCard returns a pointer to of the CreditCard value passed in.
+func CreditCard(v strfmt.CreditCard) *strfmt.CreditCard {
+	return &v
+}
+
+// CreditCardValue returns the value of the CreditCard pointer passed in or
+// the default value if the pointer is nil.
+func CreditCardValue(v *strfmt.CreditCard) strfmt.CreditCard {
+	if v == nil {
+		return strfmt.CreditCard("")
+	}
+
+	return *v
+}
+
+// SSN returns a pointer to of the SSN value passed in.
+func SSN(v strfmt.SSN) *strfmt.SSN {
+	return &v
+}
+
+// SSNValue returns the value of the SSN pointer passed in or
+// the default value if the pointer is nil.
+func SSNValue(v *strfmt.SSN) strfmt.SSN {
+	if v == nil {
+		return strfmt.SSN("")
+	}
+
+	return *v
+}
+
+// HexColor returns a pointer to of the HexColor value passed in.
+func HexColor(v strfmt.HexColor) *strfmt.HexColor {
+	return &v
+}
+
+// HexColorValue returns the value of the HexColor pointer passed in or
+// the default value if the pointer is nil.
+func HexColorValue(v *strfmt.HexColor) strfmt.HexColor {
+	if v == nil {
+		return strfmt.HexColor("")
+	}
+
+	return *v
+}
+
+// RGBColor returns a pointer to of the RGBColor value passed in.
+func RGBColor(v strfmt.RGBColor) *strfmt.RGBColor {
+	return &v
+}
+
+// RGBColorValue returns the value of the RGBColor pointer passed in or
+// the default value if the pointer is nil.
+func RGBColorValue(v *strfmt.RGBColor) strfmt.RGBColor {
+	if v == nil {
+		return strfmt.RGBColor("")
+	}
+
+	return *v
+}
+
+// Password returns a pointer to of the Password value passed in.
+func Password(v strfmt.Password) *strfmt.Password {
+	return &v
+}
+
+// PasswordValue returns the value of the Password pointer passed in or
+// the default value if the pointer is nil.
+func PasswordValue(v *strfmt.Password) strfmt.Password {
+	if v == nil {
+		return strfmt.Password("")
+	}
+
+	return *v
+}
+
+// URIReference returns a pointer to of the URIReference value passed in.
+func URIReference(v strfmt.URIReference) *strfmt.URIReference {
+	return &v
+}
+
+// URIReferenceValue returns the value of the URIReference pointer passed in or
+// the default value if the pointer is nil.
+func URIReferenceValue(v *strfmt.URIReference) strfmt.URIReference {
+	if v == nil {
+		return strfmt.URIReference("")
+	}
+
+	return *v
+}
+
+// IRI returns a pointer to of the IRI value passed in.
+func IRI(v strfmt.IRI) *strfmt.IRI {
+	return &v
+}
+
+// IRIValue returns the value of the IRI pointer passed in or
+// the default value if the pointer is nil.
+func IRIValue(v *strfmt.IRI) strfmt.IRI {
+	if v == nil {
+		return strfmt.IRI("")
+	}
+
+	return *v
+}
+
+// IRIReference returns a pointer to of the IRIReference value passed in.
+func IRIReference(v strfmt.IRIReference) *strfmt.IRIReference {
+	return &v
+}
+
+// IRIReferenceValue returns the value of the IRIReference pointer passed in or
+// the default value if the pointer is nil.
+func IRIReferenceValue(v *strfmt.IRIReference) strfmt.IRIReference {
+	if v == nil {
+		return strfmt.IRIReference("")
+	}
+
+	return *v
+}
+
+// IDNEmail returns a pointer to of the IDNEmail value passed in.
+func IDNEmail(v strfmt.IDNEmail) *strfmt.IDNEmail {
+	return &v
+}
+
+// IDNEmailValue returns the value of the IDNEmail pointer passed in or
+// the default value if the pointer is nil.
+func IDNEmailValue(v *strfmt.IDNEmail) strfmt.IDNEmail {
+	if v == nil {
+		return strfmt.IDNEmail("")
+	}
+
+	return *v
+}
+
+// IDNHostname returns a pointer to of the IDNHostname value passed in.
+func IDNHostname(v strfmt.IDNHostname) *strfmt.IDNHostname {
+	return &v
+}
+
+// IDNHostnameValue returns the value of the IDNHostname pointer passed in or
+// the default value if the pointer is nil.
+func IDNHostnameValue(v *strfmt.IDNHostname) strfmt.IDNHostname {
+	if v == nil {
+		return strfmt.IDNHostname("")
+	}
+
+	return *v
+}
+
+// URITemplate returns a pointer to of the URITemplate value passed in.
+func URITemplate(v strfmt.URITemplate) *strfmt.URITemplate {
+	return &v
+}
+
+// URITemplateValue returns the value of the URITemplate pointer passed in or
+// the default value if the pointer is nil.
+func URITemplateValue(v *strfmt.URITemplate) strfmt.URITemplate {
+	if v == nil {
+		return strfmt.URITemplate("")
+	}
+
+	return *v
+}
+
+// JSONPointer returns a pointer to of the JSONPointer value passed in.
+func JSONPointer(v strfmt.JSONPointer) *strfmt.JSONPointer {
+	return &v
+}
+
+// JSONPointerValue returns the value of the JSONPointer pointer passed in or
+// the default value if the pointer is nil.
+func JSONPointerValue(v *strfmt.JSONPointer) strfmt.JSONPointer {
+	if v == nil {
+		return strfmt.JSONPointer("")
+	}
+
+	return *v
+}
+
+// RelativeJSONPointer returns a pointer to of the RelativeJSONPointer value passed in.
+func RelativeJSONPointer(v strfmt.RelativeJSONPointer) *strfmt.RelativeJSONPointer {
+	return &v
+}
+
+// RelativeJSONPointerValue returns the value of the RelativeJSONPointer pointer passed in or
+// the default value if the pointer is nil.
+func RelativeJSONPointerValue(v *strfmt.RelativeJSONPointer) strfmt.RelativeJSONPointer {
+	if v == nil {
+		return strfmt.RelativeJSONPointer("")
+	}
+
+	return *v
+}
+
+// Regex returns a pointer to of the Regex value passed in.
+func Regex(v strfmt.Regex) *strfmt.Regex {
+	return &v
+}
+
+// RegexValue returns the value of the Regex pointer passed in or
+// the default value if the pointer is nil.
+func RegexValue(v *strfmt.Regex) strfmt.Regex {
+	if v == nil {
+		return strfmt.Regex("")
+	}
+
+	return *v
+}
@@ -16,3 +16,33 @@ func DurationValue(v *strfmt.Duration) strfmt.Duration {
 
 	return *v
 }
+
+// ISODuration returns a pointer to of the ISODuration value passed in.
+func ISODuration(v strfmt.ISODuration) *strfmt.ISODuration {
+	return &v
+}
+
+// ISODurationValue returns the value of the ISODuration pointer passed in or
+// the default value if the pointer is nil.
+func ISODurationValue(v *strfmt.ISODuration) strfmt.ISODuration {
+	if v == nil {
+		return strfmt.ISODuration(0)
+	}
+
+	return *v
+}
+
+// HumanDuration returns a pointer to of the HumanDuration value passed in.
+func HumanDuration(v strfmt.HumanDuration) *strfmt.HumanDuration {
+	return &v
+}
+
+// HumanDurationValue returns the value of the HumanDuration pointer passed in or
+// the default value if the pointer is nil.
+func HumanDurationValue(v *strfmt.HumanDuration) strfmt.HumanDuration {
+	if v == nil {
+		return strfmt.HumanDuration(0)
+	}
+
+	return *v
+}
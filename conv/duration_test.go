@@ -12,3 +12,15 @@ func TestDurationValue(t *testing.T) {
 	duration := strfmt.Duration(42)
 	assert.Equal(t, duration, DurationValue(&duration))
 }
+
+func TestISODurationValue(t *testing.T) {
+	assert.Equal(t, strfmt.ISODuration(0), ISODurationValue(nil))
+	duration := strfmt.ISODuration(42)
+	assert.Equal(t, duration, ISODurationValue(&duration))
+}
+
+func TestHumanDurationValue(t *testing.T) {
+	assert.Equal(t, strfmt.HumanDuration(0), HumanDurationValue(nil))
+	duration := strfmt.HumanDuration(42)
+	assert.Equal(t, duration, HumanDurationValue(&duration))
+}
@@ -0,0 +1,63 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// IP returns a pointer to of the IP value passed in.
+func IP(v strfmt.IP) *strfmt.IP {
+	return &v
+}
+
+// IPValue returns the value of the IP pointer passed in or
+// the default value if the pointer is nil.
+func IPValue(v *strfmt.IP) strfmt.IP {
+	if v == nil {
+		return strfmt.IP("")
+	}
+
+	return *v
+}
+
+// CIDR returns a pointer to of the CIDR value passed in.
+func CIDR(v strfmt.CIDR) *strfmt.CIDR {
+	return &v
+}
+
+// CIDRValue returns the value of the CIDR pointer passed in or
+// the default value if the pointer is nil.
+func CIDRValue(v *strfmt.CIDR) strfmt.CIDR {
+	if v == nil {
+		return strfmt.CIDR("")
+	}
+
+	return *v
+}
+
+// Port returns a pointer to of the Port value passed in.
+func Port(v strfmt.Port) *strfmt.Port {
+	return &v
+}
+
+// PortValue returns the value of the Port pointer passed in or
+// the default value if the pointer is nil.
+func PortValue(v *strfmt.Port) strfmt.Port {
+	if v == nil {
+		return strfmt.Port(0)
+	}
+
+	return *v
+}
+
+// HostPort returns a pointer to of the HostPort value passed in.
+func HostPort(v strfmt.HostPort) *strfmt.HostPort {
+	return &v
+}
+
+// HostPortValue returns the value of the HostPort pointer passed in or
+// the default value if the pointer is nil.
+func HostPortValue(v *strfmt.HostPort) strfmt.HostPort {
+	if v == nil {
+		return strfmt.HostPort("")
+	}
+
+	return *v
+}
@@ -0,0 +1,32 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIPValue(t *testing.T) {
+	assert.Equal(t, strfmt.IP(""), IPValue(nil))
+	v := strfmt.IP("192.168.1.1")
+	assert.Equal(t, v, IPValue(&v))
+}
+
+func TestCIDRValue(t *testing.T) {
+	assert.Equal(t, strfmt.CIDR(""), CIDRValue(nil))
+	v := strfmt.CIDR("10.0.0.0/8")
+	assert.Equal(t, v, CIDRValue(&v))
+}
+
+func TestPortValue(t *testing.T) {
+	assert.Equal(t, strfmt.Port(0), PortValue(nil))
+	v := strfmt.Port(8080)
+	assert.Equal(t, v, PortValue(&v))
+}
+
+func TestHostPortValue(t *testing.T) {
+	assert.Equal(t, strfmt.HostPort(""), HostPortValue(nil))
+	v := strfmt.HostPort("[::1]:8080")
+	assert.Equal(t, v, HostPortValue(&v))
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// Period returns a pointer to of the Period value passed in.
+func Period(v strfmt.Period) *strfmt.Period {
+	return &v
+}
+
+// PeriodValue returns the value of the Period pointer passed in or
+// the default value if the pointer is nil.
+func PeriodValue(v *strfmt.Period) strfmt.Period {
+	if v == nil {
+		return strfmt.Period{}
+	}
+
+	return *v
+}
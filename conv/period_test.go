@@ -0,0 +1,14 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodValue(t *testing.T) {
+	assert.Equal(t, strfmt.Period{}, PeriodValue(nil))
+	period := strfmt.Period{Months: 1}
+	assert.Equal(t, period, PeriodValue(&period))
+}
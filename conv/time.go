@@ -16,3 +16,78 @@ func DateTimeValue(v *strfmt.DateTime) strfmt.DateTime {
 
 	return *v
 }
+
+// DateTimeSeconds returns a pointer to of the DateTimeSeconds value passed in.
+func DateTimeSeconds(v strfmt.DateTimeSeconds) *strfmt.DateTimeSeconds {
+	return &v
+}
+
+// DateTimeSecondsValue returns the value of the DateTimeSeconds pointer passed in or
+// the default value if the pointer is nil.
+func DateTimeSecondsValue(v *strfmt.DateTimeSeconds) strfmt.DateTimeSeconds {
+	if v == nil {
+		return strfmt.DateTimeSeconds{}
+	}
+
+	return *v
+}
+
+// DateTimeMicro returns a pointer to of the DateTimeMicro value passed in.
+func DateTimeMicro(v strfmt.DateTimeMicro) *strfmt.DateTimeMicro {
+	return &v
+}
+
+// DateTimeMicroValue returns the value of the DateTimeMicro pointer passed in or
+// the default value if the pointer is nil.
+func DateTimeMicroValue(v *strfmt.DateTimeMicro) strfmt.DateTimeMicro {
+	if v == nil {
+		return strfmt.DateTimeMicro{}
+	}
+
+	return *v
+}
+
+// DateTimeNano returns a pointer to of the DateTimeNano value passed in.
+func DateTimeNano(v strfmt.DateTimeNano) *strfmt.DateTimeNano {
+	return &v
+}
+
+// DateTimeNanoValue returns the value of the DateTimeNano pointer passed in or
+// the default value if the pointer is nil.
+func DateTimeNanoValue(v *strfmt.DateTimeNano) strfmt.DateTimeNano {
+	if v == nil {
+		return strfmt.DateTimeNano{}
+	}
+
+	return *v
+}
+
+// DateTimeUTC returns a pointer to of the DateTimeUTC value passed in.
+func DateTimeUTC(v strfmt.DateTimeUTC) *strfmt.DateTimeUTC {
+	return &v
+}
+
+// DateTimeUTCValue returns the value of the DateTimeUTC pointer passed in or
+// the default value if the pointer is nil.
+func DateTimeUTCValue(v *strfmt.DateTimeUTC) strfmt.DateTimeUTC {
+	if v == nil {
+		return strfmt.DateTimeUTC{}
+	}
+
+	return *v
+}
+
+// Time returns a pointer to of the Time value passed in.
+func Time(v strfmt.Time) *strfmt.Time {
+	return &v
+}
+
+// TimeValue returns the value of the Time pointer passed in or
+// the default value if the pointer is nil.
+func TimeValue(v *strfmt.Time) strfmt.Time {
+	if v == nil {
+		return strfmt.Time{}
+	}
+
+	return *v
+}
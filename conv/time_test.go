@@ -13,3 +13,33 @@ func TestDateTimeValue(t *testing.T) {
 	time := strfmt.DateTime(time.Now())
 	assert.Equal(t, time, DateTimeValue(&time))
 }
+
+func TestDateTimeSecondsValue(t *testing.T) {
+	assert.Equal(t, strfmt.DateTimeSeconds{}, DateTimeSecondsValue(nil))
+	time := strfmt.DateTimeSeconds(time.Now())
+	assert.Equal(t, time, DateTimeSecondsValue(&time))
+}
+
+func TestDateTimeMicroValue(t *testing.T) {
+	assert.Equal(t, strfmt.DateTimeMicro{}, DateTimeMicroValue(nil))
+	time := strfmt.DateTimeMicro(time.Now())
+	assert.Equal(t, time, DateTimeMicroValue(&time))
+}
+
+func TestDateTimeNanoValue(t *testing.T) {
+	assert.Equal(t, strfmt.DateTimeNano{}, DateTimeNanoValue(nil))
+	time := strfmt.DateTimeNano(time.Now())
+	assert.Equal(t, time, DateTimeNanoValue(&time))
+}
+
+func TestDateTimeUTCValue(t *testing.T) {
+	assert.Equal(t, strfmt.DateTimeUTC{}, DateTimeUTCValue(nil))
+	time := strfmt.DateTimeUTC(time.Now())
+	assert.Equal(t, time, DateTimeUTCValue(&time))
+}
+
+func TestTimeValue(t *testing.T) {
+	assert.Equal(t, strfmt.Time{}, TimeValue(nil))
+	time := strfmt.NewTime(8, 30, 6, 0, time.UTC)
+	assert.Equal(t, time, TimeValue(&time))
+}
@@ -0,0 +1,18 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// TimeZone returns a pointer to of the TimeZone value passed in.
+func TimeZone(v strfmt.TimeZone) *strfmt.TimeZone {
+	return &v
+}
+
+// TimeZoneValue returns the value of the TimeZone pointer passed in or
+// the default value if the pointer is nil.
+func TimeZoneValue(v *strfmt.TimeZone) strfmt.TimeZone {
+	if v == nil {
+		return strfmt.TimeZone("")
+	}
+
+	return *v
+}
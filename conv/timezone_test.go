@@ -0,0 +1,14 @@
+package conv
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeZoneValue(t *testing.T) {
+	assert.Equal(t, strfmt.TimeZone(""), TimeZoneValue(nil))
+	tz := strfmt.TimeZone("Europe/Paris")
+	assert.Equal(t, tz, TimeZoneValue(&tz))
+}
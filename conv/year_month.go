@@ -0,0 +1,33 @@
+package conv
+
+import "github.com/go-openapi/strfmt"
+
+// YearMonth returns a pointer to of the YearMonth value passed in.
+func YearMonth(v strfmt.YearMonth) *strfmt.YearMonth {
+	return &v
+}
+
+// YearMonthValue returns the value of the YearMonth pointer passed in or
+// the default value if the pointer is nil.
+func YearMonthValue(v *strfmt.YearMonth) strfmt.YearMonth {
+	if v == nil {
+		return strfmt.YearMonth{}
+	}
+
+	return *v
+}
+
+// Year returns a pointer to of the Year value passed in.
+func Year(v strfmt.Year) *strfmt.Year {
+	return &v
+}
+
+// YearValue returns the value of the Year pointer passed in or
+// the default value if the pointer is nil.
+func YearValue(v *strfmt.Year) strfmt.Year {
+	if v == nil {
+		return strfmt.Year(0)
+	}
+
+	return *v
+}
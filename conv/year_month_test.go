@@ -0,0 +1,21 @@
+package conv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYearMonthValue(t *testing.T) {
+	assert.Equal(t, strfmt.YearMonth{}, YearMonthValue(nil))
+	ym := strfmt.YearMonth{Year: 2024, Month: time.May}
+	assert.Equal(t, ym, YearMonthValue(&ym))
+}
+
+func TestYearValue(t *testing.T) {
+	assert.Equal(t, strfmt.Year(0), YearValue(nil))
+	year := strfmt.Year(2024)
+	assert.Equal(t, year, YearValue(&year))
+}
@@ -29,7 +29,13 @@ import (
 func init() {
 	d := Date{}
 	// register this format in the default registry
-	Default.Add("date", &d, IsDate)
+	AddWithInfo(Default, "date", &d, ValidateDate, FormatInfo{
+		Description:     "A full-date, as defined by RFC 3339 section 5.6",
+		Pattern:         `^[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])$`,
+		MaxLength:       10,
+		Examples:        []string{"2017-12-22", "2000-02-29"},
+		InvalidExamples: []string{"2017-1-1", "2017-02-29", "2017-12-22T01:02:03Z"},
+	})
 }
 
 // IsDate returns true when the string is a valid date
@@ -38,6 +44,38 @@ func IsDate(str string) bool {
 	return err == nil
 }
 
+// ValidateDate returns a *FormatError when the string is not a valid date
+func ValidateDate(str string) error {
+	return validateDate("date", str)
+}
+
+func validateDate(name, str string) error {
+	if _, err := time.Parse(RFC3339FullDate, str); err == nil {
+		return nil
+	}
+
+	// locate the failure: the layout is YYYY-MM-DD
+	for i := 0; i < len(RFC3339FullDate); i++ {
+		if i >= len(str) {
+			return newFormatError(name, str, i, ReasonInvalidLength)
+		}
+		if RFC3339FullDate[i] == '-' {
+			if str[i] != '-' {
+				return newFormatError(name, str, i, ReasonInvalidCharacter)
+			}
+		} else if !isDigit(str[i]) {
+			return newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+	}
+	if len(str) > len(RFC3339FullDate) {
+		return newFormatError(name, str, len(RFC3339FullDate), ReasonInvalidLength)
+	}
+	if month := str[5:7]; month < "01" || month > "12" {
+		return newFormatError(name, str, 5, ReasonOutOfRange)
+	}
+	return newFormatError(name, str, 8, ReasonOutOfRange)
+}
+
 const (
 	// RFC3339FullDate represents a full-date as specified by RFC3339
 	// See: http://goo.gl/xXOvVd
@@ -0,0 +1,188 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ISOWeekDateLayout describes an ISO 8601 week date, e.g. 2024-W05-3, where 0 stands for a digit
+	ISOWeekDateLayout = "0000-W00-0"
+	// OrdinalDateLayout describes an ISO 8601 ordinal date, e.g. 2024-035, where 0 stands for a digit
+	OrdinalDateLayout = "0000-000"
+)
+
+// NewDate returns the date of the given year, month and day, normalized like time.Date
+func NewDate(year int, month time.Month, day int) Date {
+	return Date(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// civil returns the midnight in UTC of the calendar date of d in its location.
+//
+// The calendar helpers work on civil dates, so they ignore the time of day and
+// the location of the underlying time, and return dates in UTC.
+func (d Date) civil() time.Time {
+	year, month, day := time.Time(d).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// AddDays returns the date n days after d, or before when n is negative
+func (d Date) AddDays(n int) Date {
+	return Date(d.civil().AddDate(0, 0, n))
+}
+
+// AddMonths returns the date n months after d, or before when n is negative.
+//
+// The day of the month is clamped to the end of the resulting month: January 31st
+// plus 1 month is February 28th or 29th.
+func (d Date) AddMonths(n int) Date {
+	return Date(addMonths(d.civil(), n))
+}
+
+// AddYears returns the date n years after d, or before when n is negative.
+//
+// February 29th is clamped to February 28th in years which aren't leap years.
+func (d Date) AddYears(n int) Date {
+	return Date(addMonths(d.civil(), 12*n))
+}
+
+// Weekday returns the day of the week of d
+func (d Date) Weekday() time.Weekday {
+	return d.civil().Weekday()
+}
+
+// YearDay returns the day of the year of d, from 1 to 366
+func (d Date) YearDay() int {
+	return d.civil().YearDay()
+}
+
+// ISOWeek returns the ISO 8601 year and week number of d, from 1 to 53
+func (d Date) ISOWeek() (year, week int) {
+	return d.civil().ISOWeek()
+}
+
+// Equal reports whether d and u are the same calendar date
+func (d Date) Equal(u Date) bool {
+	return d.civil().Equal(u.civil())
+}
+
+// Before reports whether d is a calendar date before u
+func (d Date) Before(u Date) bool {
+	return d.civil().Before(u.civil())
+}
+
+// After reports whether d is a calendar date after u
+func (d Date) After(u Date) bool {
+	return d.civil().After(u.civil())
+}
+
+// Compare returns -1 if d is before u, +1 if d is after u and 0 if they are the same date
+func (d Date) Compare(u Date) int {
+	switch {
+	case d.Before(u):
+		return -1
+	case d.After(u):
+		return +1
+	default:
+		return 0
+	}
+}
+
+// DaysUntil returns the number of days from d to u, which is negative when u is before d
+func (d Date) DaysUntil(u Date) int {
+	// the unix time doesn't overflow for dates far apart, unlike time.Duration
+	return int((u.civil().Unix() - d.civil().Unix()) / (24 * 60 * 60))
+}
+
+// isoWeekday returns the ISO 8601 day of the week of t, from 1 for monday to 7 for sunday
+func isoWeekday(t time.Time) int {
+	return (int(t.Weekday())+6)%7 + 1
+}
+
+// ISOWeekDate formats d as an ISO 8601 week date, e.g. 2024-W05-3
+func (d Date) ISOWeekDate() string {
+	civil := d.civil()
+	year, week := civil.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d-%d", year, week, isoWeekday(civil))
+}
+
+// OrdinalDate formats d as an ISO 8601 ordinal date, e.g. 2024-035
+func (d Date) OrdinalDate() string {
+	civil := d.civil()
+	return fmt.Sprintf("%04d-%03d", civil.Year(), civil.YearDay())
+}
+
+// ParseISOWeekDate parses an ISO 8601 week date, e.g. 2024-W05-3 for the
+// wednesday of the 5th week of 2024
+func ParseISOWeekDate(str string) (Date, error) {
+	const name = "week-date"
+	if err := checkDigitLayout(name, str, ISOWeekDateLayout); err != nil {
+		return Date{}, err
+	}
+	year, week, day := fourDigits(str, 0), twoDigits(str, 6), int(str[9]-'0')
+	if week < 1 || week > 53 {
+		return Date{}, newFormatError(name, str, 6, ReasonOutOfRange)
+	}
+	if day < 1 || day > 7 {
+		return Date{}, newFormatError(name, str, 9, ReasonOutOfRange)
+	}
+
+	// the first week of the year is the week of january 4th
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	t := jan4.AddDate(0, 0, (week-1)*7+day-isoWeekday(jan4))
+	if y, w := t.ISOWeek(); y != year || w != week {
+		// not all years have 53 weeks
+		return Date{}, newFormatError(name, str, 6, ReasonOutOfRange)
+	}
+	return Date(t), nil
+}
+
+// ParseOrdinalDate parses an ISO 8601 ordinal date, e.g. 2024-035 for the
+// 35th day of 2024
+func ParseOrdinalDate(str string) (Date, error) {
+	const name = "ordinal-date"
+	if err := checkDigitLayout(name, str, OrdinalDateLayout); err != nil {
+		return Date{}, err
+	}
+	year, day := fourDigits(str, 0), int(str[5]-'0')*100+twoDigits(str, 6)
+	t := time.Date(year, time.January, day, 0, 0, 0, 0, time.UTC)
+	if day < 1 || t.Year() != year {
+		return Date{}, newFormatError(name, str, 5, ReasonOutOfRange)
+	}
+	return Date(t), nil
+}
+
+// checkDigitLayout checks str follows layout, where 0 stands for a digit
+func checkDigitLayout(name, str, layout string) error {
+	for i := 0; i < len(layout); i++ {
+		if i >= len(str) {
+			return newFormatError(name, str, i, ReasonInvalidLength)
+		}
+		if layout[i] == '0' && !isDigit(str[i]) || layout[i] != '0' && str[i] != layout[i] {
+			return newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+	}
+	if len(str) > len(layout) {
+		return newFormatError(name, str, len(layout), ReasonInvalidLength)
+	}
+	return nil
+}
+
+// fourDigits returns the value of the 4 digits found at str[i:]
+func fourDigits(str string, i int) int {
+	return twoDigits(str, i)*100 + twoDigits(str, i+2)
+}
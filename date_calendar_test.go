@@ -0,0 +1,133 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDate_arithmetic(t *testing.T) {
+	d := NewDate(2024, time.January, 31)
+
+	assert.Equal(t, "2024-02-01", d.AddDays(1).String())
+	assert.Equal(t, "2023-12-31", d.AddDays(-31).String())
+	assert.Equal(t, "2024-02-29", d.AddMonths(1).String())
+	assert.Equal(t, "2024-04-30", d.AddMonths(3).String())
+	assert.Equal(t, "2023-11-30", d.AddMonths(-2).String())
+	assert.Equal(t, "2025-01-31", d.AddMonths(12).String())
+	assert.Equal(t, "2025-02-28", NewDate(2024, time.February, 29).AddYears(1).String())
+	assert.Equal(t, "2028-02-29", NewDate(2024, time.February, 29).AddYears(4).String())
+
+	// the time of day and the location are ignored
+	late := Date(time.Date(2024, 1, 31, 23, 30, 0, 0, time.FixedZone("", -5*3600)))
+	assert.Equal(t, "2024-02-01", late.AddDays(1).String())
+	assert.Equal(t, "2024-02-29", late.AddMonths(1).String())
+	assert.True(t, late.Equal(d))
+	assert.Equal(t, time.Wednesday, late.Weekday())
+}
+
+func TestDate_calendar(t *testing.T) {
+	d := NewDate(2024, time.February, 4)
+	assert.Equal(t, time.Sunday, d.Weekday())
+	assert.Equal(t, 35, d.YearDay())
+	year, week := d.ISOWeek()
+	assert.Equal(t, 2024, year)
+	assert.Equal(t, 5, week)
+
+	year, week = NewDate(2007, time.December, 31).ISOWeek()
+	assert.Equal(t, 2008, year)
+	assert.Equal(t, 1, week)
+}
+
+func TestDate_compare(t *testing.T) {
+	d := NewDate(2024, time.January, 31)
+	noon := Date(time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC))
+	next := NewDate(2024, time.February, 1)
+
+	assert.True(t, d.Equal(noon))
+	assert.False(t, d.Before(noon))
+	assert.False(t, d.After(noon))
+	assert.Equal(t, 0, d.Compare(noon))
+	assert.True(t, d.Before(next))
+	assert.Equal(t, -1, d.Compare(next))
+	assert.True(t, next.After(d))
+	assert.Equal(t, 1, next.Compare(d))
+
+	assert.Equal(t, 1, d.DaysUntil(next))
+	assert.Equal(t, -1, next.DaysUntil(d))
+	assert.Equal(t, 0, d.DaysUntil(noon))
+	assert.Equal(t, 366, NewDate(2024, time.January, 1).DaysUntil(NewDate(2025, time.January, 1)))
+	assert.Equal(t, 3651694, NewDate(1, time.January, 1).DaysUntil(NewDate(9999, time.January, 1)))
+}
+
+func TestISOWeekDate(t *testing.T) {
+	testCases := []struct {
+		str  string
+		date Date
+	}{
+		{"2024-W05-3", NewDate(2024, time.January, 31)},
+		{"2024-W01-1", NewDate(2024, time.January, 1)},
+		{"2008-W01-1", NewDate(2007, time.December, 31)},
+		{"2020-W53-5", NewDate(2021, time.January, 1)},
+		{"2010-W01-1", NewDate(2010, time.January, 4)},
+	}
+	for _, tc := range testCases {
+		d, err := ParseISOWeekDate(tc.str)
+		if assert.NoError(t, err, tc.str) {
+			assert.True(t, tc.date.Equal(d), "%s: expected %v, got %v", tc.str, tc.date, d)
+		}
+		assert.Equal(t, tc.str, tc.date.ISOWeekDate())
+	}
+
+	assertFormatError(t, parseDateError(ParseISOWeekDate("2024-W5-3")), "week-date", 7, ReasonInvalidCharacter)
+	assertFormatError(t, parseDateError(ParseISOWeekDate("2024-05-3")), "week-date", 5, ReasonInvalidCharacter)
+	assertFormatError(t, parseDateError(ParseISOWeekDate("2024-W05")), "week-date", 8, ReasonInvalidLength)
+	assertFormatError(t, parseDateError(ParseISOWeekDate("2024-W05-33")), "week-date", 10, ReasonInvalidLength)
+	assertFormatError(t, parseDateError(ParseISOWeekDate("2024-W00-1")), "week-date", 6, ReasonOutOfRange)
+	assertFormatError(t, parseDateError(ParseISOWeekDate("2021-W53-1")), "week-date", 6, ReasonOutOfRange)
+	assertFormatError(t, parseDateError(ParseISOWeekDate("2024-W05-8")), "week-date", 9, ReasonOutOfRange)
+}
+
+func TestOrdinalDate(t *testing.T) {
+	testCases := []struct {
+		str  string
+		date Date
+	}{
+		{"2024-035", NewDate(2024, time.February, 4)},
+		{"2024-001", NewDate(2024, time.January, 1)},
+		{"2024-366", NewDate(2024, time.December, 31)},
+		{"2023-365", NewDate(2023, time.December, 31)},
+	}
+	for _, tc := range testCases {
+		d, err := ParseOrdinalDate(tc.str)
+		if assert.NoError(t, err, tc.str) {
+			assert.True(t, tc.date.Equal(d), "%s: expected %v, got %v", tc.str, tc.date, d)
+		}
+		assert.Equal(t, tc.str, tc.date.OrdinalDate())
+	}
+
+	assertFormatError(t, parseDateError(ParseOrdinalDate("2024-35")), "ordinal-date", 7, ReasonInvalidLength)
+	assertFormatError(t, parseDateError(ParseOrdinalDate("2024-02-04")), "ordinal-date", 7, ReasonInvalidCharacter)
+	assertFormatError(t, parseDateError(ParseOrdinalDate("2024-000")), "ordinal-date", 5, ReasonOutOfRange)
+	assertFormatError(t, parseDateError(ParseOrdinalDate("2023-366")), "ordinal-date", 5, ReasonOutOfRange)
+}
+
+// parseDateError returns the error of a date parser
+func parseDateError(_ Date, err error) error {
+	return err
+}
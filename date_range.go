@@ -0,0 +1,743 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func init() {
+	dr := DateRange{}
+	// register these formats in the default registry
+	AddWithInfo(Default, "date-range", &dr, ValidateDateRange, FormatInfo{
+		Description:     "A range of dates, as an ISO 8601 time interval of full-dates where the end is excluded",
+		Examples:        []string{"2024-01-01/2024-02-01", "2024-01-01/P1M", "P1W/2024-01-08", "../2024-01-01", "2024-01-01/.."},
+		InvalidExamples: []string{"2024-01-01", "2024-02-01/2024-01-01", "2024-01-01/PT1H", "P1D/P1D"},
+	})
+	tr := DateTimeRange{}
+	AddWithInfo(Default, "date-time-range", &tr, ValidateDateTimeRange, FormatInfo{
+		Description:     "A range of date-times, as an ISO 8601 time interval of RFC 3339 date-times where the end is excluded",
+		Examples:        []string{"2024-01-01T00:00:00Z/2024-01-01T12:00:00Z", "2024-01-01T00:00:00Z/PT12H", "../2024-01-01T00:00:00Z"},
+		InvalidExamples: []string{"2024-01-01/2024-02-01", "2024-01-01T12:00:00Z/2024-01-01T00:00:00Z"},
+	})
+}
+
+// interval is a range of times [start, end), where a zero time leaves it open.
+//
+// The bounds of Postgres ranges of date-times may also be (start or end], and
+// the empty interval has no time at all.
+type interval struct {
+	start, end     time.Time
+	startExclusive bool
+	endInclusive   bool
+	empty          bool
+}
+
+// contains reports whether t is in the interval
+func (r interval) contains(t time.Time) bool {
+	if r.empty {
+		return false
+	}
+	if !r.start.IsZero() && (t.Before(r.start) || r.startExclusive && t.Equal(r.start)) {
+		return false
+	}
+	return r.end.IsZero() || t.Before(r.end) || r.endInclusive && t.Equal(r.end)
+}
+
+// overlaps reports whether the intervals have times in common
+func (r interval) overlaps(o interval) bool {
+	_, ok := r.intersect(o)
+	return ok
+}
+
+// intersect returns the times in common with o, and false when there are none
+func (r interval) intersect(o interval) (interval, bool) {
+	if r.empty || o.empty {
+		return interval{}, false
+	}
+	i := r
+	if i.start.IsZero() || !o.start.IsZero() && (o.start.After(i.start) || o.start.Equal(i.start) && o.startExclusive) {
+		i.start, i.startExclusive = o.start, o.startExclusive
+	}
+	if i.end.IsZero() || !o.end.IsZero() && (o.end.Before(i.end) || o.end.Equal(i.end) && !o.endInclusive) {
+		i.end, i.endInclusive = o.end, o.endInclusive
+	}
+	if !i.start.IsZero() && !i.end.IsZero() && (i.end.Before(i.start) || i.end.Equal(i.start) && (i.startExclusive || !i.endInclusive)) {
+		return interval{}, false
+	}
+	return i, true
+}
+
+// split returns the consecutive intervals of length step covering r, the last
+// one being shorter when step doesn't divide r. It returns nil when r is open
+// or step doesn't move forward.
+func (r interval) split(step Period) []interval {
+	if r.empty || r.start.IsZero() || r.end.IsZero() || !step.AddTo(r.start).After(r.start) {
+		return nil
+	}
+	var parts []interval
+	// the boundaries are computed from the start, so that months don't drift
+	// when days are clamped to the end of a month
+	for k, start := 1, r.start; start.Before(r.end); k++ {
+		end := step.scale(k).AddTo(r.start)
+		if end.After(r.end) {
+			end = r.end
+		}
+		parts = append(parts, interval{start: start, end: end})
+		start = end
+	}
+	// the bounds of the range are kept at its ends
+	parts[0].startExclusive = r.startExclusive
+	parts[len(parts)-1].endInclusive = r.endInclusive
+	return parts
+}
+
+// scale returns the period repeated k times
+func (p Period) scale(k int) Period {
+	return Period{Years: k * p.Years, Months: k * p.Months, Weeks: k * p.Weeks, Days: k * p.Days, Time: time.Duration(k) * p.Time}
+}
+
+// rangeSyntax describes the endpoints of a range type
+type rangeSyntax struct {
+	name string
+	// parseISO parses an endpoint of an ISO 8601 time interval, errors locate the
+	// failure in the endpoint
+	parseISO func(str string) (time.Time, error)
+	// parseSQL parses an endpoint of a Postgres range
+	parseSQL func(str string) (time.Time, error)
+	// addPeriod returns t plus p, and false when p can't be added to the endpoints
+	addPeriod func(t time.Time, p Period) (time.Time, bool)
+	// next returns the endpoint after t for discrete ranges, whose Postgres bounds
+	// are turned into [), or nil when the bounds are kept
+	next func(t time.Time) time.Time
+}
+
+// emptyRange is the text of the empty range, as written by Postgres
+const emptyRange = "empty"
+
+// parseRange parses an ISO 8601 time interval, e.g. "2024-01-01/2024-02-01",
+// "2024-01-01/P1M", "P1M/2024-02-01" or "../2024-02-01", or a Postgres range,
+// e.g. "[2024-01-01,2024-02-01)" or "empty"
+func parseRange(str string, syntax rangeSyntax) (interval, error) {
+	if str == emptyRange {
+		return interval{empty: true}, nil
+	}
+	if str != "" && (str[0] == '[' || str[0] == '(') {
+		return parseSQLRange(str, syntax)
+	}
+
+	slash := strings.IndexByte(str, '/')
+	if slash < 0 {
+		return interval{}, newFormatError(syntax.name, str, len(str), ReasonInvalidSyntax)
+	}
+	if i := strings.IndexByte(str[slash+1:], '/'); i >= 0 {
+		return interval{}, newFormatError(syntax.name, str, slash+1+i, ReasonInvalidCharacter)
+	}
+
+	var r interval
+	var startPeriod, endPeriod *Period
+	var err error
+	if r.start, startPeriod, err = parseRangeEndpoint(str, 0, slash, syntax); err != nil {
+		return interval{}, err
+	}
+	if r.end, endPeriod, err = parseRangeEndpoint(str, slash+1, len(str), syntax); err != nil {
+		return interval{}, err
+	}
+
+	switch {
+	case startPeriod != nil && (endPeriod != nil || r.end.IsZero()):
+		return interval{}, newFormatError(syntax.name, str, slash+1, ReasonInvalidSyntax)
+	case endPeriod != nil && r.start.IsZero():
+		return interval{}, newFormatError(syntax.name, str, 0, ReasonInvalidSyntax)
+	case startPeriod != nil:
+		start, ok := syntax.addPeriod(r.end, startPeriod.Negate())
+		if !ok {
+			return interval{}, newFormatError(syntax.name, str, 0, ReasonInvalidSyntax)
+		}
+		r.start = start
+	case endPeriod != nil:
+		end, ok := syntax.addPeriod(r.start, *endPeriod)
+		if !ok {
+			return interval{}, newFormatError(syntax.name, str, slash+1, ReasonInvalidSyntax)
+		}
+		r.end = end
+	}
+
+	if !r.start.IsZero() && !r.end.IsZero() && r.end.Before(r.start) {
+		return interval{}, newFormatError(syntax.name, str, slash+1, ReasonOutOfRange)
+	}
+	return r, nil
+}
+
+// parseRangeEndpoint parses str[from:to], an endpoint of an ISO 8601 time interval
+// which is either a time, a period or ".." when the interval is open
+func parseRangeEndpoint(str string, from, to int, syntax rangeSyntax) (time.Time, *Period, error) {
+	part := str[from:to]
+	switch {
+	case part == "..":
+		return time.Time{}, nil, nil
+	case isISODuration(part):
+		p, err := ParsePeriod(part)
+		if err != nil {
+			return time.Time{}, nil, shiftFormatError(err, syntax.name, str, from)
+		}
+		return time.Time{}, &p, nil
+	default:
+		t, err := syntax.parseISO(part)
+		if err != nil {
+			return time.Time{}, nil, shiftFormatError(err, syntax.name, str, from)
+		}
+		return t, nil, nil
+	}
+}
+
+// parseSQLRange parses the text of a Postgres range, e.g. "[2024-01-01,2024-02-01)",
+// where an empty or infinite bound leaves the range open
+func parseSQLRange(str string, syntax rangeSyntax) (interval, error) {
+	last := len(str) - 1
+	if last < 1 || str[last] != ')' && str[last] != ']' {
+		return interval{}, newFormatError(syntax.name, str, len(str), ReasonInvalidSyntax)
+	}
+	comma := strings.IndexByte(str, ',')
+	if comma < 0 {
+		return interval{}, newFormatError(syntax.name, str, last, ReasonInvalidSyntax)
+	}
+
+	var r interval
+	for _, bound := range []struct {
+		str       string
+		offset    int
+		t         *time.Time
+		canonical bool
+		kept      *bool
+	}{
+		{str[1:comma], 1, &r.start, str[0] == '[', &r.startExclusive},
+		{str[comma+1 : last], comma + 1, &r.end, str[last] == ')', &r.endInclusive},
+	} {
+		value := bound.str
+		if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+			value = value[1 : len(value)-1]
+		}
+		if value == "" || value == "infinity" || value == "-infinity" {
+			continue
+		}
+		t, err := syntax.parseSQL(value)
+		if err != nil {
+			return interval{}, newFormatError(syntax.name, str, bound.offset, ReasonInvalidSyntax)
+		}
+		// the canonical bounds are [), others are moved to the next endpoint
+		// of discrete ranges, or kept
+		if !bound.canonical {
+			if syntax.next != nil {
+				t = syntax.next(t)
+			} else {
+				*bound.kept = true
+			}
+		}
+		*bound.t = t
+	}
+
+	if !r.start.IsZero() && !r.end.IsZero() && r.end.Before(r.start) {
+		return interval{}, newFormatError(syntax.name, str, comma+1, ReasonOutOfRange)
+	}
+	return r, nil
+}
+
+// shiftFormatError returns err as a FormatError of name for str, when err
+// locates a failure in the substring of str starting at offset
+func shiftFormatError(err error, name, str string, offset int) error {
+	if fe, ok := err.(*FormatError); ok && fe.Offset >= 0 {
+		return newFormatError(name, str, offset+fe.Offset, fe.Reason)
+	}
+	return newFormatError(name, str, offset, ReasonInvalidSyntax)
+}
+
+// formatRange formats an ISO 8601 time interval, with ".." for open endpoints
+func formatRange(start, end string) string {
+	if start == "" {
+		start = ".."
+	}
+	if end == "" {
+		end = ".."
+	}
+	return start + "/" + end
+}
+
+// formatSQLRange formats a Postgres range, with empty values for open endpoints
+func formatSQLRange(start, end string, startExclusive, endInclusive bool) string {
+	lower, upper := "[", ")"
+	if start == "" || startExclusive {
+		lower = "("
+	}
+	if end != "" && endInclusive {
+		upper = "]"
+	}
+	return lower + start + "," + end + upper
+}
+
+var dateRangeSyntax = rangeSyntax{
+	name: "date-range",
+	parseISO: func(str string) (time.Time, error) {
+		if err := validateDate("date", str); err != nil {
+			return time.Time{}, err
+		}
+		return time.Parse(RFC3339FullDate, str)
+	},
+	parseSQL: func(str string) (time.Time, error) {
+		return time.Parse(RFC3339FullDate, str)
+	},
+	addPeriod: func(t time.Time, p Period) (time.Time, bool) {
+		return p.AddTo(t), p.Time == 0
+	},
+	next: func(t time.Time) time.Time {
+		return t.AddDate(0, 0, 1)
+	},
+}
+
+// IsDateRange returns true when the string is a valid date range
+func IsDateRange(str string) bool {
+	return ValidateDateRange(str) == nil
+}
+
+// ValidateDateRange returns a *FormatError when the string is not a valid date range
+func ValidateDateRange(str string) error {
+	_, err := ParseDateRange(str)
+	return err
+}
+
+// DateRange represents a range of dates, from Start included to End excluded.
+//
+// A zero Start or End leaves the range open on this side. It is written as an
+// ISO 8601 time interval, e.g. "2024-01-01/2024-02-01" or "../2024-02-01".
+//
+// The empty range, which has no date at all, is written "empty" as in Postgres.
+//
+// swagger:strfmt date-range
+type DateRange struct {
+	Start Date
+	End   Date
+	Empty bool
+}
+
+// ParseDateRange parses an ISO 8601 time interval of full-dates, such as
+// "2024-01-01/2024-02-01", "2024-01-01/P1M", "P1M/2024-02-01" or the open-ended
+// "../2024-02-01", or the text of a Postgres daterange, e.g. "[2024-01-01,2024-02-01)"
+// or "empty".
+//
+// Periods can't have a time component.
+func ParseDateRange(str string) (DateRange, error) {
+	r, err := parseRange(str, dateRangeSyntax)
+	if err != nil {
+		return DateRange{}, err
+	}
+	return dateRangeOf(r), nil
+}
+
+// interval returns the range of the midnights in UTC of the dates
+func (r DateRange) interval() interval {
+	i := interval{empty: r.Empty}
+	if !time.Time(r.Start).IsZero() {
+		i.start = r.Start.civil()
+	}
+	if !time.Time(r.End).IsZero() {
+		i.end = r.End.civil()
+	}
+	return i
+}
+
+func dateRangeOf(i interval) DateRange {
+	if i.empty {
+		return DateRange{Empty: true}
+	}
+	return DateRange{Start: Date(i.start), End: Date(i.end)}
+}
+
+// Contains reports whether the date d is in the range
+func (r DateRange) Contains(d Date) bool {
+	return r.interval().contains(d.civil())
+}
+
+// Overlaps reports whether the ranges have dates in common
+func (r DateRange) Overlaps(o DateRange) bool {
+	return r.interval().overlaps(o.interval())
+}
+
+// Intersect returns the dates in common with o, and false when there are none
+func (r DateRange) Intersect(o DateRange) (DateRange, bool) {
+	i, ok := r.interval().intersect(o.interval())
+	return dateRangeOf(i), ok
+}
+
+// Split returns the consecutive ranges of length step covering the range, e.g.
+// its months, the last one being shorter when step doesn't divide the range.
+//
+// It returns nil when the range is open, step doesn't move forward or has a
+// time component.
+func (r DateRange) Split(step Period) []DateRange {
+	if step.Time != 0 {
+		return nil
+	}
+	var ranges []DateRange
+	for _, i := range r.interval().split(step) {
+		ranges = append(ranges, dateRangeOf(i))
+	}
+	return ranges
+}
+
+// endpoints returns the formatted endpoints of the range, empty when open
+func (r DateRange) endpoints() (start, end string) {
+	if !time.Time(r.Start).IsZero() {
+		start = r.Start.String()
+	}
+	if !time.Time(r.End).IsZero() {
+		end = r.End.String()
+	}
+	return start, end
+}
+
+// String converts this range to an ISO 8601 time interval, the empty range to "empty"
+func (r DateRange) String() string {
+	if r.Empty {
+		return emptyRange
+	}
+	return formatRange(r.endpoints())
+}
+
+// MarshalText turns this instance into text
+func (r DateRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (r *DateRange) UnmarshalText(data []byte) error {
+	rr, err := ParseDateRange(string(data))
+	if err != nil {
+		return err
+	}
+	*r = rr
+	return nil
+}
+
+// Scan reads a DateRange value from database driver type, either an ISO 8601
+// time interval or the text of a Postgres daterange.
+func (r *DateRange) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return r.UnmarshalText(v)
+	case string:
+		return r.UnmarshalText([]byte(v))
+	case nil:
+		*r = DateRange{}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.DateRange from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts DateRange to the text of a Postgres daterange, e.g. "[2024-01-01,2024-02-01)".
+func (r DateRange) Value() (driver.Value, error) {
+	if r.Empty {
+		return driver.Value(emptyRange), nil
+	}
+	start, end := r.endpoints()
+	return driver.Value(formatSQLRange(start, end, false, false)), nil
+}
+
+// MarshalJSON returns the DateRange as JSON
+func (r DateRange) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	r.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the DateRange to a easyjson.Writer
+func (r DateRange) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(r.String())
+}
+
+// UnmarshalJSON sets the DateRange from JSON
+func (r *DateRange) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	r.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the DateRange from a easyjson.Lexer
+func (r *DateRange) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		rr, err := ParseDateRange(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*r = rr
+	}
+}
+
+// GetBSON returns the DateRange as a bson.M{} map.
+func (r *DateRange) GetBSON() (interface{}, error) {
+	return bson.M{"data": r.String()}, nil
+}
+
+// SetBSON sets the DateRange from raw bson data
+func (r *DateRange) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return r.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as DateRange")
+}
+
+var dateTimeRangeSyntax = rangeSyntax{
+	name: "date-time-range",
+	parseISO: func(str string) (time.Time, error) {
+		return DateTimeParser{Strict: true}.Parse(str)
+	},
+	parseSQL: func(str string) (time.Time, error) {
+		// Postgres writes offsets in hours when they are whole, e.g. "2024-01-01 00:00:00+00"
+		if n := len(str); n > 3 && (str[n-3] == '+' || str[n-3] == '-') && isDigit(str[n-2]) && isDigit(str[n-1]) {
+			str += ":00"
+		}
+		return DateTimeParser{}.Parse(str)
+	},
+	addPeriod: func(t time.Time, p Period) (time.Time, bool) {
+		return p.AddTo(t), true
+	},
+}
+
+// IsDateTimeRange returns true when the string is a valid date-time range
+func IsDateTimeRange(str string) bool {
+	return ValidateDateTimeRange(str) == nil
+}
+
+// ValidateDateTimeRange returns a *FormatError when the string is not a valid date-time range
+func ValidateDateTimeRange(str string) error {
+	_, err := ParseDateTimeRange(str)
+	return err
+}
+
+// DateTimeRange represents a range of date-times, from Start included to End excluded.
+//
+// A zero Start or End leaves the range open on this side. It is written as an
+// ISO 8601 time interval, e.g. "2024-01-01T00:00:00.000Z/2024-01-01T12:00:00.000Z".
+//
+// StartExclusive and EndInclusive keep the other bounds of Postgres tstzranges,
+// e.g. `("2024-01-01 00:00:00+00","2024-01-01 12:00:00+00"]`: a range with such
+// bounds is written in the syntax of Postgres, which ISO 8601 intervals lack.
+// The empty range, which has no date-time at all, is written "empty" as in Postgres.
+//
+// swagger:strfmt date-time-range
+type DateTimeRange struct {
+	Start          DateTime
+	End            DateTime
+	StartExclusive bool
+	EndInclusive   bool
+	Empty          bool
+}
+
+// ParseDateTimeRange parses an ISO 8601 time interval of RFC 3339 date-times,
+// such as "2024-01-01T00:00:00Z/2024-01-01T12:00:00Z", "2024-01-01T00:00:00Z/PT12H"
+// or the open-ended "../2024-01-01T00:00:00Z", or the text of a Postgres tstzrange,
+// e.g. `["2024-01-01 00:00:00+00","2024-01-01 12:00:00+00")` or "empty".
+//
+// The bounds of Postgres ranges other than [) are kept in StartExclusive and EndInclusive.
+func ParseDateTimeRange(str string) (DateTimeRange, error) {
+	r, err := parseRange(str, dateTimeRangeSyntax)
+	if err != nil {
+		return DateTimeRange{}, err
+	}
+	return dateTimeRangeOf(r), nil
+}
+
+func (r DateTimeRange) interval() interval {
+	return interval{
+		start:          time.Time(r.Start),
+		end:            time.Time(r.End),
+		startExclusive: r.StartExclusive,
+		endInclusive:   r.EndInclusive,
+		empty:          r.Empty,
+	}
+}
+
+func dateTimeRangeOf(i interval) DateTimeRange {
+	if i.empty {
+		return DateTimeRange{Empty: true}
+	}
+	return DateTimeRange{
+		Start:          DateTime(i.start),
+		End:            DateTime(i.end),
+		StartExclusive: i.startExclusive && !i.start.IsZero(),
+		EndInclusive:   i.endInclusive && !i.end.IsZero(),
+	}
+}
+
+// Contains reports whether the date-time t is in the range
+func (r DateTimeRange) Contains(t DateTime) bool {
+	return r.interval().contains(time.Time(t))
+}
+
+// Overlaps reports whether the ranges have date-times in common
+func (r DateTimeRange) Overlaps(o DateTimeRange) bool {
+	return r.interval().overlaps(o.interval())
+}
+
+// Intersect returns the date-times in common with o, and false when there are none
+func (r DateTimeRange) Intersect(o DateTimeRange) (DateTimeRange, bool) {
+	i, ok := r.interval().intersect(o.interval())
+	return dateTimeRangeOf(i), ok
+}
+
+// Split returns the consecutive ranges of length step covering the range, e.g.
+// its days, the last one being shorter when step doesn't divide the range.
+//
+// It returns nil when the range is open or step doesn't move forward.
+func (r DateTimeRange) Split(step Period) []DateTimeRange {
+	var ranges []DateTimeRange
+	for _, i := range r.interval().split(step) {
+		ranges = append(ranges, dateTimeRangeOf(i))
+	}
+	return ranges
+}
+
+// endpoints returns the formatted endpoints of the range, empty when open
+func (r DateTimeRange) endpoints() (start, end string) {
+	if !time.Time(r.Start).IsZero() {
+		start = r.Start.String()
+	}
+	if !time.Time(r.End).IsZero() {
+		end = r.End.String()
+	}
+	return start, end
+}
+
+// String converts this range to an ISO 8601 time interval, or to the text of a
+// Postgres tstzrange when its bounds aren't [), the empty range to "empty"
+func (r DateTimeRange) String() string {
+	if r.Empty {
+		return emptyRange
+	}
+	start, end := r.endpoints()
+	if start != "" && r.StartExclusive || end != "" && r.EndInclusive {
+		return formatSQLRange(start, end, r.StartExclusive, r.EndInclusive)
+	}
+	return formatRange(start, end)
+}
+
+// MarshalText turns this instance into text
+func (r DateTimeRange) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (r *DateTimeRange) UnmarshalText(data []byte) error {
+	rr, err := ParseDateTimeRange(string(data))
+	if err != nil {
+		return err
+	}
+	*r = rr
+	return nil
+}
+
+// Scan reads a DateTimeRange value from database driver type, either an ISO 8601
+// time interval or the text of a Postgres tstzrange.
+func (r *DateTimeRange) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return r.UnmarshalText(v)
+	case string:
+		return r.UnmarshalText([]byte(v))
+	case nil:
+		*r = DateTimeRange{}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.DateTimeRange from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts DateTimeRange to the text of a Postgres tstzrange,
+// e.g. "[2024-01-01T00:00:00.000Z,2024-01-01T12:00:00.000Z)".
+func (r DateTimeRange) Value() (driver.Value, error) {
+	if r.Empty {
+		return driver.Value(emptyRange), nil
+	}
+	start, end := r.endpoints()
+	return driver.Value(formatSQLRange(start, end, r.StartExclusive, r.EndInclusive)), nil
+}
+
+// MarshalJSON returns the DateTimeRange as JSON
+func (r DateTimeRange) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	r.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the DateTimeRange to a easyjson.Writer
+func (r DateTimeRange) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(r.String())
+}
+
+// UnmarshalJSON sets the DateTimeRange from JSON
+func (r *DateTimeRange) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	r.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the DateTimeRange from a easyjson.Lexer
+func (r *DateTimeRange) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		rr, err := ParseDateTimeRange(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*r = rr
+	}
+}
+
+// GetBSON returns the DateTimeRange as a bson.M{} map.
+func (r *DateTimeRange) GetBSON() (interface{}, error) {
+	return bson.M{"data": r.String()}, nil
+}
+
+// SetBSON sets the DateTimeRange from raw bson data
+func (r *DateTimeRange) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return r.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as DateTimeRange")
+}
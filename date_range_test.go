@@ -0,0 +1,328 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDateRange(t *testing.T) {
+	testCases := []struct {
+		in  string
+		str string
+	}{
+		{"2024-01-01/2024-02-01", "2024-01-01/2024-02-01"},
+		{"2024-01-01/2024-01-01", "2024-01-01/2024-01-01"},
+		{"2024-01-31/P1M", "2024-01-31/2024-02-29"},
+		{"2024-01-01/P1Y14D", "2024-01-01/2025-01-15"},
+		{"P1W/2024-01-08", "2024-01-01/2024-01-08"},
+		{"../2024-01-01", "../2024-01-01"},
+		{"2024-01-01/..", "2024-01-01/.."},
+		{"../..", "../.."},
+		// postgres dateranges
+		{"[2024-01-01,2024-02-01)", "2024-01-01/2024-02-01"},
+		{"(2023-12-31,2024-01-31]", "2024-01-01/2024-02-01"},
+		{`["2024-01-01","2024-02-01")`, "2024-01-01/2024-02-01"},
+		{"(,2024-02-01)", "../2024-02-01"},
+		{"[2024-01-01,infinity)", "2024-01-01/.."},
+		{"(-infinity,)", "../.."},
+		{"empty", "empty"},
+	}
+	for _, tc := range testCases {
+		r, err := ParseDateRange(tc.in)
+		if assert.NoError(t, err, tc.in) {
+			assert.Equal(t, tc.str, r.String(), tc.in)
+		}
+	}
+
+	assertFormatError(t, ValidateDateRange("2024-01-01"), "date-range", 10, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("2024-01-01/2024-02-01/2024-03-01"), "date-range", 21, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateDateRange("2024-01-01/2024-13-01"), "date-range", 16, ReasonOutOfRange)
+	assertFormatError(t, ValidateDateRange("2024-02-01/2024-01-01"), "date-range", 11, ReasonOutOfRange)
+	assertFormatError(t, ValidateDateRange("2024-01-01/PT1H"), "date-range", 11, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("2024-01-01/P1.5D"), "date-range", 12, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("P1D/P1D"), "date-range", 4, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("P1D/.."), "date-range", 4, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("../P1D"), "date-range", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("[2024-01-01,2024-02-01"), "date-range", 22, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("[2024-01-01)"), "date-range", 11, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("[2024-01-01,yada)"), "date-range", 12, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateRange("[2024-02-01,2024-01-01)"), "date-range", 12, ReasonOutOfRange)
+	assert.Error(t, ValidateDateRange("Empty"))
+}
+
+func TestDateRange_operations(t *testing.T) {
+	jan, err := ParseDateRange("2024-01-01/2024-02-01")
+	assert.NoError(t, err)
+	assert.True(t, jan.Contains(NewDate(2024, time.January, 1)))
+	assert.True(t, jan.Contains(NewDate(2024, time.January, 31)))
+	assert.False(t, jan.Contains(NewDate(2024, time.February, 1)))
+	assert.False(t, jan.Contains(NewDate(2023, time.December, 31)))
+	// the time of day and location are ignored
+	assert.True(t, jan.Contains(Date(time.Date(2024, 1, 31, 23, 0, 0, 0, time.FixedZone("", -3600)))))
+
+	before, _ := ParseDateRange("../2024-01-15")
+	assert.True(t, before.Contains(NewDate(1900, time.January, 1)))
+	assert.True(t, DateRange{}.Contains(NewDate(1900, time.January, 1)))
+
+	feb, _ := ParseDateRange("2024-02-01/P1M")
+	assert.False(t, jan.Overlaps(feb))
+	assert.False(t, feb.Overlaps(jan))
+	assert.True(t, jan.Overlaps(before))
+	assert.True(t, DateRange{}.Overlaps(feb))
+	empty, _ := ParseDateRange("2024-01-10/2024-01-10")
+	assert.False(t, jan.Overlaps(empty))
+
+	i, ok := jan.Intersect(before)
+	assert.True(t, ok)
+	assert.Equal(t, "2024-01-01/2024-01-15", i.String())
+	i, ok = before.Intersect(DateRange{})
+	assert.True(t, ok)
+	assert.Equal(t, "../2024-01-15", i.String())
+	_, ok = jan.Intersect(feb)
+	assert.False(t, ok)
+
+	q1, _ := ParseDateRange("2024-01-31/2024-04-15")
+	var months []string
+	for _, m := range q1.Split(Period{Months: 1}) {
+		months = append(months, m.String())
+	}
+	assert.Equal(t, []string{"2024-01-31/2024-02-29", "2024-02-29/2024-03-31", "2024-03-31/2024-04-15"}, months)
+	assert.Len(t, jan.Split(Period{Weeks: 1}), 5)
+	assert.Nil(t, before.Split(Period{Days: 1}))
+	assert.Nil(t, jan.Split(Period{}))
+	assert.Nil(t, jan.Split(Period{Days: -1}))
+	assert.Nil(t, jan.Split(Period{Time: 12 * time.Hour}))
+
+	// the empty range has no date at all
+	none, err := ParseDateRange("empty")
+	assert.NoError(t, err)
+	assert.Equal(t, DateRange{Empty: true}, none)
+	assert.False(t, none.Contains(NewDate(2024, time.January, 1)))
+	assert.False(t, none.Overlaps(DateRange{}))
+	assert.False(t, DateRange{}.Overlaps(none))
+	_, ok = jan.Intersect(none)
+	assert.False(t, ok)
+	assert.Nil(t, none.Split(Period{Days: 1}))
+}
+
+func TestDateRange_marshaling(t *testing.T) {
+	const str = "2024-01-01/2024-02-01"
+	r, err := ParseDateRange(str)
+	assert.NoError(t, err)
+
+	txt, err := r.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, str, string(txt))
+	var back DateRange
+	assert.NoError(t, back.UnmarshalText(txt))
+	assert.Equal(t, str, back.String())
+
+	js, err := r.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+str+`"`, string(js))
+	back = DateRange{}
+	assert.NoError(t, back.UnmarshalJSON(js))
+	assert.Equal(t, str, back.String())
+	assert.Error(t, back.UnmarshalJSON([]byte(`"yada"`)))
+	assert.Equal(t, str, back.String())
+
+	val, err := r.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[2024-01-01,2024-02-01)", val)
+	val, err = DateRange{End: r.End}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "(,2024-02-01)", val)
+
+	back = DateRange{}
+	assert.NoError(t, back.Scan("[2024-01-01,2024-02-01)"))
+	assert.Equal(t, str, back.String())
+	assert.NoError(t, back.Scan([]byte(str)))
+	assert.Equal(t, str, back.String())
+	assert.NoError(t, back.Scan("empty"))
+	assert.Equal(t, DateRange{Empty: true}, back)
+	val, err = back.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "empty", val)
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, DateRange{}, back)
+	assert.Error(t, back.Scan(42))
+
+	bsonData, err := bson.Marshal(&r)
+	assert.NoError(t, err)
+	back = DateRange{}
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, str, back.String())
+
+	v, err := Default.Parse("daterange", str)
+	assert.NoError(t, err)
+	assert.Equal(t, str, v.(*DateRange).String())
+}
+
+func TestParseDateTimeRange(t *testing.T) {
+	testCases := []struct {
+		in  string
+		str string
+	}{
+		{"2024-01-01T00:00:00Z/2024-01-01T12:00:00Z", "2024-01-01T00:00:00.000Z/2024-01-01T12:00:00.000Z"},
+		{"2024-01-01T00:00:00+01:00/PT12H", "2024-01-01T00:00:00.000+01:00/2024-01-01T12:00:00.000+01:00"},
+		{"2024-01-31T08:00:00Z/P1M", "2024-01-31T08:00:00.000Z/2024-02-29T08:00:00.000Z"},
+		{"PT30M/2024-01-01T12:00:00Z", "2024-01-01T11:30:00.000Z/2024-01-01T12:00:00.000Z"},
+		{"../2024-01-01T00:00:00Z", "../2024-01-01T00:00:00.000Z"},
+		// postgres tstzranges
+		{`["2024-01-01 00:00:00+00","2024-01-01 12:00:00+00")`, "2024-01-01T00:00:00.000Z/2024-01-01T12:00:00.000Z"},
+		{`["2024-01-01 00:00:00.5+05:30",)`, "2024-01-01T00:00:00.500+05:30/.."},
+		{`[2024-01-01T00:00:00Z,2024-01-02T00:00:00Z)`, "2024-01-01T00:00:00.000Z/2024-01-02T00:00:00.000Z"},
+		{`(,2024-01-02T00:00:00Z)`, "../2024-01-02T00:00:00.000Z"},
+		{`("2024-01-01 00:00:00+00",)`, "(2024-01-01T00:00:00.000Z,)"},
+		{`[,"2024-01-01 00:00:00+00"]`, "(,2024-01-01T00:00:00.000Z]"},
+		{`(2024-01-01T00:00:00Z,2024-01-02T00:00:00Z]`, "(2024-01-01T00:00:00.000Z,2024-01-02T00:00:00.000Z]"},
+		{`(,infinity]`, "../.."},
+		{"empty", "empty"},
+	}
+	for _, tc := range testCases {
+		r, err := ParseDateTimeRange(tc.in)
+		if assert.NoError(t, err, tc.in) {
+			assert.Equal(t, tc.str, r.String(), tc.in)
+		}
+	}
+
+	assertFormatError(t, ValidateDateTimeRange("2024-01-01/2024-02-01"), "date-time-range", 10, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateTimeRange("2024-01-01T00:00:00Z/2024-01-01T25:00:00Z"), "date-time-range", 32, ReasonOutOfRange)
+	assertFormatError(t, ValidateDateTimeRange("2024-01-01T12:00:00Z/2024-01-01T00:00:00Z"), "date-time-range", 21, ReasonOutOfRange)
+	assertFormatError(t, ValidateDateTimeRange(`("2024-01-02 00:00:00+00","2024-01-01 00:00:00+00"]`), "date-time-range", 26, ReasonOutOfRange)
+}
+
+func TestDateTimeRange_operations(t *testing.T) {
+	day, err := ParseDateTimeRange("2024-01-01T00:00:00Z/P1D")
+	assert.NoError(t, err)
+
+	noon, _ := ParseDateTime("2024-01-01T12:00:00Z")
+	midnight, _ := ParseDateTime("2024-01-02T00:00:00Z")
+	assert.True(t, day.Contains(noon))
+	assert.False(t, day.Contains(midnight))
+	// offsets are taken into account
+	late, _ := ParseDateTime("2024-01-01T23:30:00-01:00")
+	assert.False(t, day.Contains(late))
+
+	afternoon, _ := ParseDateTimeRange("2024-01-01T12:00:00Z/..")
+	assert.True(t, day.Overlaps(afternoon))
+	next, _ := ParseDateTimeRange("2024-01-02T00:00:00Z/PT1H")
+	assert.False(t, day.Overlaps(next))
+
+	i, ok := day.Intersect(afternoon)
+	assert.True(t, ok)
+	assert.Equal(t, "2024-01-01T12:00:00.000Z/2024-01-02T00:00:00.000Z", i.String())
+	_, ok = day.Intersect(next)
+	assert.False(t, ok)
+
+	parts := day.Split(Period{Time: 5 * time.Hour})
+	if assert.Len(t, parts, 5) {
+		assert.Equal(t, "2024-01-01T00:00:00.000Z/2024-01-01T05:00:00.000Z", parts[0].String())
+		assert.Equal(t, "2024-01-01T20:00:00.000Z/2024-01-02T00:00:00.000Z", parts[4].String())
+	}
+	assert.Nil(t, afternoon.Split(Period{Time: time.Hour}))
+
+	// the bounds of postgres ranges are kept
+	closed, err := ParseDateTimeRange(`("2024-01-01 00:00:00+00","2024-01-02 00:00:00+00"]`)
+	assert.NoError(t, err)
+	assert.True(t, closed.StartExclusive)
+	assert.True(t, closed.EndInclusive)
+	assert.False(t, closed.Contains(day.Start))
+	assert.True(t, closed.Contains(noon))
+	assert.True(t, closed.Contains(midnight))
+	assert.True(t, closed.Overlaps(next))
+	assert.False(t, closed.Overlaps(DateTimeRange{End: day.Start, EndInclusive: true}))
+	assert.True(t, day.Overlaps(DateTimeRange{End: day.Start, EndInclusive: true}))
+	i, ok = closed.Intersect(next)
+	assert.True(t, ok)
+	assert.Equal(t, "[2024-01-02T00:00:00.000Z,2024-01-02T00:00:00.000Z]", i.String())
+	i, ok = closed.Intersect(day)
+	assert.True(t, ok)
+	assert.Equal(t, "(2024-01-01T00:00:00.000Z,2024-01-02T00:00:00.000Z)", i.String())
+	parts = closed.Split(Period{Time: 12 * time.Hour})
+	if assert.Len(t, parts, 2) {
+		assert.Equal(t, "(2024-01-01T00:00:00.000Z,2024-01-01T12:00:00.000Z)", parts[0].String())
+		assert.Equal(t, "[2024-01-01T12:00:00.000Z,2024-01-02T00:00:00.000Z]", parts[1].String())
+	}
+
+	// the empty range has no date-time at all
+	none, err := ParseDateTimeRange("empty")
+	assert.NoError(t, err)
+	assert.Equal(t, DateTimeRange{Empty: true}, none)
+	assert.False(t, none.Contains(noon))
+	assert.False(t, none.Overlaps(DateTimeRange{}))
+	_, ok = day.Intersect(none)
+	assert.False(t, ok)
+	assert.Nil(t, none.Split(Period{Time: time.Hour}))
+}
+
+func TestDateTimeRange_marshaling(t *testing.T) {
+	const str = "2024-01-01T00:00:00.000Z/2024-01-01T12:00:00.000Z"
+	r, err := ParseDateTimeRange(str)
+	assert.NoError(t, err)
+
+	js, err := r.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+str+`"`, string(js))
+	var back DateTimeRange
+	assert.NoError(t, back.UnmarshalJSON(js))
+	assert.Equal(t, str, back.String())
+	assert.Error(t, back.UnmarshalJSON([]byte(`"yada"`)))
+
+	val, err := r.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[2024-01-01T00:00:00.000Z,2024-01-01T12:00:00.000Z)", val)
+	back = DateTimeRange{}
+	assert.NoError(t, back.Scan(val))
+	assert.Equal(t, str, back.String())
+	assert.NoError(t, back.Scan([]byte(`["2024-01-01 00:00:00+00","2024-01-01 12:00:00+00")`)))
+	assert.Equal(t, str, back.String())
+	assert.NoError(t, back.Scan(`("2024-01-01 00:00:00+00","2024-01-01 12:00:00+00"]`))
+	assert.Equal(t, "(2024-01-01T00:00:00.000Z,2024-01-01T12:00:00.000Z]", back.String())
+	val, err = back.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "(2024-01-01T00:00:00.000Z,2024-01-01T12:00:00.000Z]", val)
+	js, err = back.MarshalJSON()
+	assert.NoError(t, err)
+	closed := DateTimeRange{}
+	assert.NoError(t, closed.UnmarshalJSON(js))
+	assert.Equal(t, back.String(), closed.String())
+	assert.True(t, closed.StartExclusive)
+	assert.True(t, closed.EndInclusive)
+	assert.NoError(t, back.Scan("empty"))
+	assert.Equal(t, DateTimeRange{Empty: true}, back)
+	val, err = back.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "empty", val)
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, DateTimeRange{}, back)
+	assert.Error(t, back.Scan(42))
+
+	bsonData, err := bson.Marshal(&r)
+	assert.NoError(t, err)
+	back = DateTimeRange{}
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, str, back.String())
+
+	v, err := Default.Parse("date-time-range", str)
+	assert.NoError(t, err)
+	assert.Equal(t, str, v.(*DateTimeRange).String())
+}
@@ -0,0 +1,467 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// DateTimePrecision is the number of digits of the fraction of second of a formatted date-time
+type DateTimePrecision int
+
+const (
+	// PrecisionSeconds formats date-times without fraction of second
+	PrecisionSeconds DateTimePrecision = 0
+	// PrecisionMillis formats date-times to the millisecond
+	PrecisionMillis DateTimePrecision = 3
+	// PrecisionMicros formats date-times to the microsecond
+	PrecisionMicros DateTimePrecision = 6
+	// PrecisionNanos formats date-times to the nanosecond
+	PrecisionNanos DateTimePrecision = 9
+)
+
+// DateTimeFormat describes how a date-time is formatted.
+//
+// The zero value formats to the second and preserves the offset of the time.
+type DateTimeFormat struct {
+	// Precision is the number of digits of the fraction of second, always written
+	Precision DateTimePrecision
+	// UTC converts the time to UTC before formatting it, instead of preserving its offset
+	UTC bool
+}
+
+// Layout returns the time layout of the format, in the syntax of the time package
+func (f DateTimeFormat) Layout() string {
+	if f.Precision <= 0 {
+		return time.RFC3339
+	}
+	return "2006-01-02T15:04:05." + strings.Repeat("0", int(f.Precision)) + "Z07:00"
+}
+
+// Format formats a time as a RFC 3339 date-time
+func (f DateTimeFormat) Format(t time.Time) string {
+	if f.UTC {
+		t = t.UTC()
+	}
+	return t.Format(f.Layout())
+}
+
+// scanDateTime scans a date-time from a database driver type
+func scanDateTime(raw interface{}, typeName string) (time.Time, error) {
+	switch v := raw.(type) {
+	case []byte:
+		return parseDateTime(DateTimeParser{}, string(v))
+	case string:
+		return parseDateTime(DateTimeParser{}, v)
+	case time.Time:
+		return v, nil
+	case int64:
+		return DateTimeParser{}.parseNumber(strconv.FormatInt(v, 10))
+	case float64:
+		return DateTimeParser{}.ParseEpoch(v)
+	case nil:
+		return time.Time{}, nil
+	default:
+		return time.Time{}, fmt.Errorf("cannot sql.Scan() strfmt.%s from: %#v", typeName, v)
+	}
+}
+
+// readDateTime reads a date-time from a easyjson.Lexer with p, either a string
+// or an epoch number, it returns false on error
+func readDateTime(in *jlexer.Lexer, p DateTimeParser) (time.Time, bool) {
+	if in.IsNull() {
+		// reports null as an invalid token
+		_ = in.String()
+		return time.Time{}, false
+	}
+	raw := in.Raw()
+	if !in.Ok() {
+		return time.Time{}, false
+	}
+
+	var tt time.Time
+	var err error
+	if len(raw) > 0 && raw[0] == '"' {
+		// strings are never epochs, even when they are made of digits
+		l := jlexer.Lexer{Data: raw}
+		str := l.String()
+		if err = l.Error(); err == nil {
+			tt, err = parseDateTime(p, str)
+		}
+	} else {
+		tt, err = p.parseNumber(string(raw))
+	}
+	if err != nil {
+		in.AddError(err)
+		return time.Time{}, false
+	}
+	return tt, true
+}
+
+// unmarshalDateTimeJSON reads a date-time from JSON with p
+func unmarshalDateTimeJSON(data []byte, p DateTimeParser) (time.Time, bool, error) {
+	l := jlexer.Lexer{Data: data}
+	tt, ok := readDateTime(&l, p)
+	return tt, ok, l.Error()
+}
+
+// setDateTimeBSON reads a date-time from raw bson data
+func setDateTimeBSON(raw bson.Raw, typeName string) (time.Time, error) {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return time.Time{}, err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return parseDateTime(DateTimeParser{}, data)
+	}
+
+	return time.Time{}, errors.New("couldn't unmarshal bson raw value as " + typeName)
+}
+
+// dateTimeType implements the methods of the date-time types serializing with a
+// DateTimeFormat, on their underlying time
+type dateTimeType struct {
+	name   string
+	format DateTimeFormat
+}
+
+var (
+	// the date-time types, whose format can't be changed at run time
+	dateTimeSecondsType = dateTimeType{"DateTimeSeconds", DateTimeFormat{Precision: PrecisionSeconds}}
+	dateTimeMicroType   = dateTimeType{"DateTimeMicro", DateTimeFormat{Precision: PrecisionMicros}}
+	dateTimeNanoType    = dateTimeType{"DateTimeNano", DateTimeFormat{Precision: PrecisionNanos}}
+	dateTimeUTCType     = dateTimeType{"DateTimeUTC", DateTimeFormat{Precision: PrecisionMillis, UTC: true}}
+)
+
+// set sets *t to tt, in UTC when the format is in UTC
+func (d dateTimeType) set(t *time.Time, tt time.Time) {
+	if d.format.UTC {
+		tt = tt.UTC()
+	}
+	*t = tt
+}
+
+func (d dateTimeType) parse(t *time.Time, p DateTimeParser, str string) error {
+	tt, err := parseDateTime(p, str)
+	if err != nil {
+		return err
+	}
+	d.set(t, tt)
+	return nil
+}
+
+func (d dateTimeType) scan(t *time.Time, raw interface{}) error {
+	tt, err := scanDateTime(raw, d.name)
+	if err != nil {
+		return err
+	}
+	d.set(t, tt)
+	return nil
+}
+
+func (d dateTimeType) marshalJSON(t time.Time) ([]byte, error) {
+	var w jwriter.Writer
+	w.String(d.format.Format(t))
+	return w.BuildBytes()
+}
+
+func (d dateTimeType) unmarshalJSON(t *time.Time, data []byte) error {
+	tt, ok, err := unmarshalDateTimeJSON(data, DateTimeParser{})
+	if ok {
+		d.set(t, tt)
+	}
+	return err
+}
+
+func (d dateTimeType) unmarshalEasyJSON(t *time.Time, in *jlexer.Lexer) {
+	if tt, ok := readDateTime(in, DateTimeParser{}); ok {
+		d.set(t, tt)
+	}
+}
+
+func (d dateTimeType) setBSON(t *time.Time, raw bson.Raw) error {
+	tt, err := setDateTimeBSON(raw, d.name)
+	if err != nil {
+		return err
+	}
+	d.set(t, tt)
+	return nil
+}
+
+// DateTimeSeconds is a DateTime which serializes to the second
+type DateTimeSeconds time.Time
+
+// String converts this time to a string
+func (t DateTimeSeconds) String() string {
+	return dateTimeSecondsType.format.Format(time.Time(t))
+}
+
+// MarshalText implements the text marshaller interface
+func (t DateTimeSeconds) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller interface
+func (t *DateTimeSeconds) UnmarshalText(text []byte) error {
+	return dateTimeSecondsType.parse((*time.Time)(t), DateTimeParser{}, string(text))
+}
+
+// parseDateTime sets the DateTimeSeconds from a string parsed with p
+func (t *DateTimeSeconds) parseDateTime(p DateTimeParser, str string) error {
+	return dateTimeSecondsType.parse((*time.Time)(t), p, str)
+}
+
+// Scan scans a DateTimeSeconds value from database driver type.
+func (t *DateTimeSeconds) Scan(raw interface{}) error {
+	return dateTimeSecondsType.scan((*time.Time)(t), raw)
+}
+
+// Value converts DateTimeSeconds to a primitive value ready to written to a database.
+func (t DateTimeSeconds) Value() (driver.Value, error) {
+	return driver.Value(t.String()), nil
+}
+
+// MarshalJSON returns the DateTimeSeconds as JSON
+func (t DateTimeSeconds) MarshalJSON() ([]byte, error) {
+	return dateTimeSecondsType.marshalJSON(time.Time(t))
+}
+
+// MarshalEasyJSON writes the DateTimeSeconds to a easyjson.Writer
+func (t DateTimeSeconds) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(t.String())
+}
+
+// UnmarshalJSON sets the DateTimeSeconds from JSON
+func (t *DateTimeSeconds) UnmarshalJSON(data []byte) error {
+	return dateTimeSecondsType.unmarshalJSON((*time.Time)(t), data)
+}
+
+// UnmarshalEasyJSON sets the DateTimeSeconds from a easyjson.Lexer
+func (t *DateTimeSeconds) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	dateTimeSecondsType.unmarshalEasyJSON((*time.Time)(t), in)
+}
+
+// GetBSON returns the DateTimeSeconds as a bson.M{} map.
+func (t *DateTimeSeconds) GetBSON() (interface{}, error) {
+	return bson.M{"data": t.String()}, nil
+}
+
+// SetBSON sets the DateTimeSeconds from raw bson data
+func (t *DateTimeSeconds) SetBSON(raw bson.Raw) error {
+	return dateTimeSecondsType.setBSON((*time.Time)(t), raw)
+}
+
+// DateTimeMicro is a DateTime which serializes to the microsecond
+type DateTimeMicro time.Time
+
+// String converts this time to a string
+func (t DateTimeMicro) String() string {
+	return dateTimeMicroType.format.Format(time.Time(t))
+}
+
+// MarshalText implements the text marshaller interface
+func (t DateTimeMicro) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller interface
+func (t *DateTimeMicro) UnmarshalText(text []byte) error {
+	return dateTimeMicroType.parse((*time.Time)(t), DateTimeParser{}, string(text))
+}
+
+// parseDateTime sets the DateTimeMicro from a string parsed with p
+func (t *DateTimeMicro) parseDateTime(p DateTimeParser, str string) error {
+	return dateTimeMicroType.parse((*time.Time)(t), p, str)
+}
+
+// Scan scans a DateTimeMicro value from database driver type.
+func (t *DateTimeMicro) Scan(raw interface{}) error {
+	return dateTimeMicroType.scan((*time.Time)(t), raw)
+}
+
+// Value converts DateTimeMicro to a primitive value ready to written to a database.
+func (t DateTimeMicro) Value() (driver.Value, error) {
+	return driver.Value(t.String()), nil
+}
+
+// MarshalJSON returns the DateTimeMicro as JSON
+func (t DateTimeMicro) MarshalJSON() ([]byte, error) {
+	return dateTimeMicroType.marshalJSON(time.Time(t))
+}
+
+// MarshalEasyJSON writes the DateTimeMicro to a easyjson.Writer
+func (t DateTimeMicro) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(t.String())
+}
+
+// UnmarshalJSON sets the DateTimeMicro from JSON
+func (t *DateTimeMicro) UnmarshalJSON(data []byte) error {
+	return dateTimeMicroType.unmarshalJSON((*time.Time)(t), data)
+}
+
+// UnmarshalEasyJSON sets the DateTimeMicro from a easyjson.Lexer
+func (t *DateTimeMicro) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	dateTimeMicroType.unmarshalEasyJSON((*time.Time)(t), in)
+}
+
+// GetBSON returns the DateTimeMicro as a bson.M{} map.
+func (t *DateTimeMicro) GetBSON() (interface{}, error) {
+	return bson.M{"data": t.String()}, nil
+}
+
+// SetBSON sets the DateTimeMicro from raw bson data
+func (t *DateTimeMicro) SetBSON(raw bson.Raw) error {
+	return dateTimeMicroType.setBSON((*time.Time)(t), raw)
+}
+
+// DateTimeNano is a DateTime which serializes to the nanosecond
+type DateTimeNano time.Time
+
+// String converts this time to a string
+func (t DateTimeNano) String() string {
+	return dateTimeNanoType.format.Format(time.Time(t))
+}
+
+// MarshalText implements the text marshaller interface
+func (t DateTimeNano) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller interface
+func (t *DateTimeNano) UnmarshalText(text []byte) error {
+	return dateTimeNanoType.parse((*time.Time)(t), DateTimeParser{}, string(text))
+}
+
+// parseDateTime sets the DateTimeNano from a string parsed with p
+func (t *DateTimeNano) parseDateTime(p DateTimeParser, str string) error {
+	return dateTimeNanoType.parse((*time.Time)(t), p, str)
+}
+
+// Scan scans a DateTimeNano value from database driver type.
+func (t *DateTimeNano) Scan(raw interface{}) error {
+	return dateTimeNanoType.scan((*time.Time)(t), raw)
+}
+
+// Value converts DateTimeNano to a primitive value ready to written to a database.
+func (t DateTimeNano) Value() (driver.Value, error) {
+	return driver.Value(t.String()), nil
+}
+
+// MarshalJSON returns the DateTimeNano as JSON
+func (t DateTimeNano) MarshalJSON() ([]byte, error) {
+	return dateTimeNanoType.marshalJSON(time.Time(t))
+}
+
+// MarshalEasyJSON writes the DateTimeNano to a easyjson.Writer
+func (t DateTimeNano) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(t.String())
+}
+
+// UnmarshalJSON sets the DateTimeNano from JSON
+func (t *DateTimeNano) UnmarshalJSON(data []byte) error {
+	return dateTimeNanoType.unmarshalJSON((*time.Time)(t), data)
+}
+
+// UnmarshalEasyJSON sets the DateTimeNano from a easyjson.Lexer
+func (t *DateTimeNano) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	dateTimeNanoType.unmarshalEasyJSON((*time.Time)(t), in)
+}
+
+// GetBSON returns the DateTimeNano as a bson.M{} map.
+func (t *DateTimeNano) GetBSON() (interface{}, error) {
+	return bson.M{"data": t.String()}, nil
+}
+
+// SetBSON sets the DateTimeNano from raw bson data
+func (t *DateTimeNano) SetBSON(raw bson.Raw) error {
+	return dateTimeNanoType.setBSON((*time.Time)(t), raw)
+}
+
+// DateTimeUTC is a DateTime which serializes to the millisecond, in UTC.
+//
+// Date-times are converted to UTC when they are read, so that they compare
+// with == whatever the offset they were written with.
+type DateTimeUTC time.Time
+
+// String converts this time to a string
+func (t DateTimeUTC) String() string {
+	return dateTimeUTCType.format.Format(time.Time(t))
+}
+
+// MarshalText implements the text marshaller interface
+func (t DateTimeUTC) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the text unmarshaller interface
+func (t *DateTimeUTC) UnmarshalText(text []byte) error {
+	return dateTimeUTCType.parse((*time.Time)(t), DateTimeParser{}, string(text))
+}
+
+// parseDateTime sets the DateTimeUTC from a string parsed with p
+func (t *DateTimeUTC) parseDateTime(p DateTimeParser, str string) error {
+	return dateTimeUTCType.parse((*time.Time)(t), p, str)
+}
+
+// Scan scans a DateTimeUTC value from database driver type.
+func (t *DateTimeUTC) Scan(raw interface{}) error {
+	return dateTimeUTCType.scan((*time.Time)(t), raw)
+}
+
+// Value converts DateTimeUTC to a primitive value ready to written to a database.
+func (t DateTimeUTC) Value() (driver.Value, error) {
+	return driver.Value(t.String()), nil
+}
+
+// MarshalJSON returns the DateTimeUTC as JSON
+func (t DateTimeUTC) MarshalJSON() ([]byte, error) {
+	return dateTimeUTCType.marshalJSON(time.Time(t))
+}
+
+// MarshalEasyJSON writes the DateTimeUTC to a easyjson.Writer
+func (t DateTimeUTC) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(t.String())
+}
+
+// UnmarshalJSON sets the DateTimeUTC from JSON
+func (t *DateTimeUTC) UnmarshalJSON(data []byte) error {
+	return dateTimeUTCType.unmarshalJSON((*time.Time)(t), data)
+}
+
+// UnmarshalEasyJSON sets the DateTimeUTC from a easyjson.Lexer
+func (t *DateTimeUTC) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	dateTimeUTCType.unmarshalEasyJSON((*time.Time)(t), in)
+}
+
+// GetBSON returns the DateTimeUTC as a bson.M{} map.
+func (t *DateTimeUTC) GetBSON() (interface{}, error) {
+	return bson.M{"data": t.String()}, nil
+}
+
+// SetBSON sets the DateTimeUTC from raw bson data
+func (t *DateTimeUTC) SetBSON(raw bson.Raw) error {
+	return dateTimeUTCType.setBSON((*time.Time)(t), raw)
+}
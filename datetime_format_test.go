@@ -0,0 +1,179 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTimeFormat(t *testing.T) {
+	paris := time.FixedZone("CET", 3600)
+	tm := time.Date(2011, 8, 18, 19, 3, 37, 123456789, paris)
+
+	testCases := []struct {
+		format DateTimeFormat
+		layout string
+		str    string
+	}{
+		{DateTimeFormat{}, time.RFC3339, "2011-08-18T19:03:37+01:00"},
+		{DateTimeFormat{Precision: PrecisionMillis}, RFC3339Millis, "2011-08-18T19:03:37.123+01:00"},
+		{DateTimeFormat{Precision: PrecisionMicros}, RFC3339Micro, "2011-08-18T19:03:37.123456+01:00"},
+		{DateTimeFormat{Precision: PrecisionNanos}, "2006-01-02T15:04:05.000000000Z07:00", "2011-08-18T19:03:37.123456789+01:00"},
+		{DateTimeFormat{UTC: true}, time.RFC3339, "2011-08-18T18:03:37Z"},
+		{DateTimeFormat{Precision: PrecisionMillis, UTC: true}, RFC3339Millis, "2011-08-18T18:03:37.123Z"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.layout, tc.format.Layout())
+		assert.Equal(t, tc.str, tc.format.Format(tm))
+	}
+
+	// the fraction of second is always written
+	assert.Equal(t, "2014-12-15T08:00:00.000000000Z", DateTimeFormat{Precision: PrecisionNanos}.Format(time.Date(2014, 12, 15, 8, 0, 0, 0, time.UTC)))
+}
+
+// dateTimeValue is the marshaling surface shared by the date-time types
+type dateTimeValue interface {
+	Format
+	json.Marshaler
+	json.Unmarshaler
+	driver.Valuer
+	Scan(interface{}) error
+}
+
+func TestDateTime_precisions(t *testing.T) {
+	paris := time.FixedZone("CET", 3600)
+	tm := time.Date(2011, 8, 18, 19, 3, 37, 123456789, paris)
+
+	testCases := []struct {
+		value func(time.Time) dateTimeValue
+		empty func() dateTimeValue
+		str   string
+	}{
+		{
+			func(t time.Time) dateTimeValue { v := DateTime(t); return &v },
+			func() dateTimeValue { return new(DateTime) },
+			"2011-08-18T19:03:37.123+01:00",
+		},
+		{
+			func(t time.Time) dateTimeValue { v := DateTimeSeconds(t); return &v },
+			func() dateTimeValue { return new(DateTimeSeconds) },
+			"2011-08-18T19:03:37+01:00",
+		},
+		{
+			func(t time.Time) dateTimeValue { v := DateTimeMicro(t); return &v },
+			func() dateTimeValue { return new(DateTimeMicro) },
+			"2011-08-18T19:03:37.123456+01:00",
+		},
+		{
+			func(t time.Time) dateTimeValue { v := DateTimeNano(t); return &v },
+			func() dateTimeValue { return new(DateTimeNano) },
+			"2011-08-18T19:03:37.123456789+01:00",
+		},
+		{
+			func(t time.Time) dateTimeValue { v := DateTimeUTC(t); return &v },
+			func() dateTimeValue { return new(DateTimeUTC) },
+			"2011-08-18T18:03:37.123Z",
+		},
+	}
+	for _, tc := range testCases {
+		dt := tc.value(tm)
+		assert.Equal(t, tc.str, dt.String())
+
+		txt, err := dt.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.str, string(txt))
+
+		js, err := dt.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, `"`+tc.str+`"`, string(js))
+
+		val, err := dt.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.str, val)
+
+		// the value is read back to the precision it was written with
+		back := tc.empty()
+		assert.NoError(t, back.UnmarshalText(txt))
+		assert.Equal(t, tc.str, back.String())
+
+		back = tc.empty()
+		assert.NoError(t, back.UnmarshalJSON(js))
+		assert.Equal(t, tc.str, back.String())
+		assert.Error(t, back.UnmarshalJSON([]byte(`"yada"`)))
+		assert.Equal(t, tc.str, back.String())
+
+		back = tc.empty()
+		assert.NoError(t, back.Scan(tc.str))
+		assert.Equal(t, tc.str, back.String())
+		assert.NoError(t, back.Scan(tm))
+		assert.Equal(t, tc.str, back.String())
+		assert.Error(t, back.Scan(true))
+
+		bsonData, err := bson.Marshal(dt)
+		assert.NoError(t, err)
+		back = tc.empty()
+		assert.NoError(t, bson.Unmarshal(bsonData, back))
+		assert.Equal(t, tc.str, back.String())
+	}
+}
+
+func TestDateTime_registryPrecision(t *testing.T) {
+	// a registry may parse date-times with another precision than its parent
+	registry := NewFormats().(*defaultFormats)
+	registry.AddE("datetime", &DateTimeNano{}, ValidateDateTime)
+
+	v, err := registry.Parse("date-time", "2011-08-18T19:03:37.123456789+01:00")
+	assert.NoError(t, err)
+	assert.IsType(t, &DateTimeNano{}, v)
+	assert.Equal(t, "2011-08-18T19:03:37.123456789+01:00", v.(*DateTimeNano).String())
+
+	v, err = Default.Parse("date-time", "2011-08-18T19:03:37.123456789+01:00")
+	assert.NoError(t, err)
+	assert.Equal(t, "2011-08-18T19:03:37.123+01:00", v.(*DateTime).String())
+}
+
+func TestMarshalFormat_deprecated(t *testing.T) {
+	// MarshalFormat still sets the layout of DateTime until it is removed
+	defer func(f string) { MarshalFormat = f }(MarshalFormat)
+	MarshalFormat = time.RFC3339Nano
+
+	tm := DateTime(time.Date(2011, 8, 18, 19, 3, 37, 123456789, time.UTC))
+	const str = "2011-08-18T19:03:37.123456789Z"
+	assert.Equal(t, str, tm.String())
+	txt, err := tm.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, str, string(txt))
+	b, err := tm.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+str+`"`, string(b))
+	val, err := tm.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, str, val)
+	bsonData, err := bson.Marshal(&tm)
+	assert.NoError(t, err)
+	var back DateTime
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, str, back.String())
+
+	// the other date-time types keep their precision
+	assert.Equal(t, "2011-08-18T19:03:37.123Z", DateTimeUTC(tm).String())
+}
@@ -0,0 +1,181 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// localDateTimeLayout is the layout of date-times without offset,
+// a fraction of second is accepted by time.Parse after the seconds
+const localDateTimeLayout = "2006-01-02T15:04:05"
+
+// epochMillisThreshold is the magnitude from which epoch numbers are taken
+// for milliseconds rather than seconds when the unit is not known:
+// 1e11 seconds is in the year 5138, 1e11 milliseconds in 1973
+const epochMillisThreshold = 1e11
+
+// DateTimeParser parses date-times.
+//
+// The zero value is a lenient parser, which accepts on top of RFC 3339 date-times:
+//   - a space or a lower case "t" between the date and the time
+//   - date-times without offset, in Location
+//
+// Strings are never read as unix epochs, which only come from numbers: JSON
+// numbers, integer or float columns and ParseEpoch.
+//
+// A registry parses a date-time format with a DateTimeParser set with UseDateTimeParser.
+type DateTimeParser struct {
+	// Strict only accepts RFC 3339 date-times, as the date-time format validator
+	// does, and rejects epochs
+	Strict bool
+	// Location is the location of date-times without offset, UTC when nil
+	Location *time.Location
+	// EpochUnit is the unit of unix epochs: time.Second, time.Millisecond,
+	// time.Microsecond or time.Nanosecond.
+	// When zero, epochs with a magnitude of 1e11 or more are in milliseconds,
+	// other ones in seconds.
+	EpochUnit time.Duration
+	// Normalize converts the parsed date-times to this location, e.g. time.UTC,
+	// so that they serialize alike whatever their offset. The offset of the
+	// date-time is preserved when nil.
+	//
+	// DateTimeUTC values are always converted to UTC.
+	Normalize *time.Location
+}
+
+// Parse parses a date-time
+func (p DateTimeParser) Parse(str string) (time.Time, error) {
+	return p.normalize(p.parse(str))
+}
+
+// normalize converts a parsed date-time to the Normalize location
+func (p DateTimeParser) normalize(t time.Time, err error) (time.Time, error) {
+	if err != nil || p.Normalize == nil {
+		return t, err
+	}
+	return t.In(p.Normalize), nil
+}
+
+func (p DateTimeParser) parse(str string) (time.Time, error) {
+	if p.Strict {
+		if err := ValidateDateTime(str); err != nil {
+			return time.Time{}, err
+		}
+		// RFC 3339 allows lower case separators, the time package doesn't
+		return time.Parse(time.RFC3339Nano, strings.ToUpper(str))
+	}
+
+	if len(str) > 10 && (str[10] == ' ' || str[10] == 't') {
+		str = str[:10] + "T" + str[11:]
+	}
+	if strings.HasSuffix(str, "z") {
+		str = str[:len(str)-1] + "Z"
+	}
+
+	var lastError error
+	for _, layout := range dateTimeFormats {
+		tt, err := time.Parse(layout, str)
+		if err == nil {
+			return tt, nil
+		}
+		lastError = err
+	}
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	if tt, err := time.ParseInLocation(localDateTimeLayout, str, loc); err == nil {
+		return tt, nil
+	}
+	return time.Time{}, lastError
+}
+
+// ParseEpoch converts a unix epoch to a date-time, in the unit of the parser.
+// A strict parser rejects epochs.
+func (p DateTimeParser) ParseEpoch(epoch float64) (time.Time, error) {
+	return p.parseNumber(strconv.FormatFloat(epoch, 'f', -1, 64))
+}
+
+// parseNumber converts a number, e.g. a JSON number, to a date-time
+func (p DateTimeParser) parseNumber(str string) (time.Time, error) {
+	if p.Strict {
+		return time.Time{}, fmt.Errorf("a unix epoch is not a RFC 3339 date-time: %s", str)
+	}
+	if !isEpoch(str) {
+		return time.Time{}, fmt.Errorf("invalid unix epoch: %s", str)
+	}
+	return p.normalize(p.parseEpoch(str))
+}
+
+// isEpoch reports whether str is a decimal number: [ "-" ] 1*DIGIT [ "." 1*DIGIT ]
+func isEpoch(str string) bool {
+	str = strings.TrimPrefix(str, "-")
+	dot := strings.IndexByte(str, '.')
+	if dot == 0 || dot == len(str)-1 {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if !isDigit(str[i]) && i != dot {
+			return false
+		}
+	}
+	return str != ""
+}
+
+// parseEpoch converts the decimal number str to a date-time, without going
+// through a float to keep the precision of the fraction
+func (p DateTimeParser) parseEpoch(str string) (time.Time, error) {
+	negative := strings.HasPrefix(str, "-")
+	str = strings.TrimPrefix(str, "-")
+	integer, fraction := str, ""
+	if dot := strings.IndexByte(str, '.'); dot >= 0 {
+		integer, fraction = str[:dot], str[dot+1:]
+	}
+	n, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	unit := p.EpochUnit
+	switch {
+	case unit == 0 && n >= epochMillisThreshold:
+		unit = time.Millisecond
+	case unit == 0:
+		unit = time.Second
+	case unit < 0 || unit > time.Second || time.Second%unit != 0:
+		return time.Time{}, fmt.Errorf("unsupported epoch unit %v", unit)
+	}
+
+	// the fraction is in nanoseconds of unit
+	if len(fraction) > 9 {
+		fraction = fraction[:9]
+	}
+	var frac int64
+	if fraction != "" {
+		frac, _ = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+	}
+
+	perSecond := int64(time.Second / unit)
+	sec := n / perSecond
+	nsec := n%perSecond*int64(unit) + frac*int64(unit)/int64(time.Second)
+	if negative {
+		sec, nsec = -sec, -nsec
+	}
+	return time.Unix(sec, nsec).UTC(), nil
+}
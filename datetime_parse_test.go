@@ -0,0 +1,178 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateTimeParser_lenient(t *testing.T) {
+	paris := time.FixedZone("CET", 3600)
+
+	testCases := []struct {
+		in   string
+		want time.Time
+	}{
+		{"2014-12-15T08:00:00.000Z", time.Date(2014, 12, 15, 8, 0, 0, 0, time.UTC)},
+		{"2011-08-18T19:03:37+01:00", time.Date(2011, 8, 18, 19, 3, 37, 0, paris)},
+		{"2024-01-02 15:04:05Z", time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"2024-01-02t15:04:05.25z", time.Date(2024, 1, 2, 15, 4, 5, 250000000, time.UTC)},
+		{"2024-01-02 15:04:05", time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)},
+		{"2024-01-02T15:04:05.123456", time.Date(2024, 1, 2, 15, 4, 5, 123456000, time.UTC)},
+	}
+	for _, tc := range testCases {
+		got, err := DateTimeParser{}.Parse(tc.in)
+		if assert.NoError(t, err, tc.in) {
+			assert.True(t, tc.want.Equal(got), "%s: expected %v, got %v", tc.in, tc.want, got)
+		}
+	}
+
+	// strings of digits are never epochs: bare years and basic format dates are rejected
+	for _, in := range []string{"", "yada", "2024-01-02", "2024-01-02 15:04", "2024", "20240102", "20240102T150405Z", "1502212350", "0", "-1.5"} {
+		_, err := DateTimeParser{}.Parse(in)
+		assert.Error(t, err, in)
+	}
+}
+
+func TestDateTimeParser_epochs(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want time.Time
+	}{
+		{"1502212350", time.Date(2017, 8, 8, 17, 12, 30, 0, time.UTC)},
+		{"1502212350.5", time.Date(2017, 8, 8, 17, 12, 30, 500000000, time.UTC)},
+		{"1502212350123", time.Date(2017, 8, 8, 17, 12, 30, 123000000, time.UTC)},
+		{"1502212350123.4", time.Date(2017, 8, 8, 17, 12, 30, 123400000, time.UTC)},
+		{"0", time.Unix(0, 0).UTC()},
+		{"-1.5", time.Date(1969, 12, 31, 23, 59, 58, 500000000, time.UTC)},
+	}
+	for _, tc := range testCases {
+		// JSON numbers keep the precision of their fraction
+		var dt DateTime
+		if assert.NoError(t, dt.UnmarshalJSON([]byte(tc.in)), tc.in) {
+			assert.True(t, tc.want.Equal(time.Time(dt)), "%s: expected %v, got %v", tc.in, tc.want, dt)
+		}
+	}
+
+	for _, in := range []string{"1e9", "99999999999999999999", "true", "[1]"} {
+		var dt DateTime
+		assert.Error(t, dt.UnmarshalJSON([]byte(in)), in)
+	}
+}
+
+func TestDateTimeParser_options(t *testing.T) {
+	paris := time.FixedZone("CET", 3600)
+
+	// date-times without offset are in the location of the parser
+	got, err := DateTimeParser{Location: paris}.Parse("2024-01-02 15:04:05")
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2024, 1, 2, 14, 4, 5, 0, time.UTC).Equal(got))
+	got, err = DateTimeParser{Location: paris}.Parse("2024-01-02 15:04:05Z")
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC).Equal(got))
+
+	// the unit of epochs may be set
+	got, err = DateTimeParser{EpochUnit: time.Millisecond}.ParseEpoch(1500)
+	assert.NoError(t, err)
+	assert.True(t, time.Unix(1, 500000000).Equal(got))
+	got, err = DateTimeParser{EpochUnit: time.Second}.ParseEpoch(1502212350123)
+	assert.NoError(t, err)
+	assert.Equal(t, 49573, got.Year())
+	got, err = DateTimeParser{EpochUnit: time.Microsecond}.ParseEpoch(1.5)
+	assert.NoError(t, err)
+	assert.True(t, time.Unix(0, 1500).Equal(got))
+	_, err = DateTimeParser{EpochUnit: time.Minute}.ParseEpoch(1)
+	assert.Error(t, err)
+}
+
+func TestDateTimeParser_strict(t *testing.T) {
+	strict := DateTimeParser{Strict: true}
+
+	got, err := strict.Parse("2024-01-02t15:04:05.123456789z")
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2024, 1, 2, 15, 4, 5, 123456789, time.UTC).Equal(got))
+
+	for _, in := range []string{"", "2024-01-02 15:04:05Z", "2024-01-02T15:04:05", "1502212350", "2024", "20240102T150405Z"} {
+		_, err := strict.Parse(in)
+		assert.Error(t, err, in)
+		assert.IsType(t, &FormatError{}, err, in)
+	}
+	_, err = strict.ParseEpoch(1502212350)
+	assert.Error(t, err)
+}
+
+func TestDateTime_epochs(t *testing.T) {
+	want := DateTime(time.Date(2017, 8, 8, 17, 12, 30, 0, time.UTC))
+
+	var dt DateTime
+	assert.NoError(t, dt.UnmarshalJSON([]byte(`1502212350`)))
+	assert.Equal(t, want.String(), dt.String())
+	assert.NoError(t, dt.UnmarshalJSON([]byte(`1502212350123`)))
+	assert.Equal(t, "2017-08-08T17:12:30.123Z", dt.String())
+	// quoted strings are never epochs
+	for _, in := range []string{`"1502212350"`, `"2024"`, `"20240102"`} {
+		assert.Error(t, dt.UnmarshalJSON([]byte(in)), in)
+		assert.Error(t, dt.UnmarshalText([]byte(in[1:len(in)-1])), in)
+	}
+	assert.Equal(t, "2017-08-08T17:12:30.123Z", dt.String())
+	assert.Error(t, dt.UnmarshalJSON([]byte(`null`)))
+	assert.Error(t, dt.UnmarshalJSON([]byte(`true`)))
+
+	dt = DateTime{}
+	assert.NoError(t, dt.Scan(int64(1502212350)))
+	assert.Equal(t, want.String(), dt.String())
+	assert.NoError(t, dt.Scan(float64(1502212350.5)))
+	assert.Equal(t, "2017-08-08T17:12:30.500Z", dt.String())
+	assert.NoError(t, dt.Scan("2017-08-08 17:12:30"))
+	assert.Equal(t, want.String(), dt.String())
+	assert.Error(t, dt.Scan("1502212350"))
+}
+
+func TestDateTime_registryParser(t *testing.T) {
+	registry := NewFormats().(*defaultFormats)
+	assert.True(t, registry.UseDateTimeParser("date-time", DateTimeParser{Strict: true}))
+	assert.False(t, registry.UseDateTimeParser("uuid", DateTimeParser{}))
+	assert.False(t, registry.UseDateTimeParser("unknown", DateTimeParser{}))
+
+	v, err := registry.Parse("date-time", "2024-01-02T15:04:05Z")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-02T15:04:05.000Z", v.(*DateTime).String())
+	for _, in := range []string{"2024-01-02 15:04:05Z", "2024-01-02T15:04:05", "2024", "20240102", ""} {
+		_, err = registry.Parse("date-time", in)
+		assert.Error(t, err, in)
+	}
+
+	var m struct {
+		At  DateTime
+		Ref *DateTime
+	}
+	assert.Error(t, decodeWith(registry.MapStructureHookFunc(), map[string]interface{}{"at": "2024-01-02 15:04:05Z"}, &m))
+	assert.Error(t, decodeWith(registry.MapStructureHookFunc(), map[string]interface{}{"ref": "20240102"}, &m))
+	assert.NoError(t, decodeWith(registry.MapStructureHookFunc(), map[string]interface{}{"at": "2024-01-02t15:04:05z"}, &m))
+	assert.Equal(t, "2024-01-02T15:04:05.000Z", m.At.String())
+
+	// the parent is left untouched, and replacing the format resets its parser
+	_, err = Default.Parse("date-time", "2024-01-02 15:04:05Z")
+	assert.NoError(t, err)
+	registry.AddE("date-time", &DateTimeNano{}, ValidateDateTime)
+	_, err = registry.Parse("date-time", "2024-01-02 15:04:05Z")
+	assert.NoError(t, err)
+
+	registry.Freeze()
+	assert.False(t, registry.UseDateTimeParser("date-time", DateTimeParser{}))
+}
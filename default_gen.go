@@ -0,0 +1,3169 @@
+// Code generated by strfmtgen from default_formats.json. DO NOT EDIT.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/asaskevich/govalidator"
+)
+
+func init() {
+	// register formats in the registry:
+	//   - uri
+	//   - email
+	//   - hostname
+	//   - ipv4
+	//   - ipv6
+	//   - mac
+	//   - uuid
+	//   - uuid3
+	//   - uuid4
+	//   - uuid5
+	//   - uuid6
+	//   - uuid7
+	//   - uuid8
+	//   - ulid
+	//   - ksuid
+	//   - isbn
+	//   - isbn10
+	//   - isbn13
+	//   - creditcard
+	//   - ssn
+	//   - hexcolor
+	//   - rgbcolor
+	//   - password
+	//   - uri-reference
+	//   - iri
+	//   - iri-reference
+	//   - idn-email
+	//   - idn-hostname
+	//   - uri-template
+	//   - json-pointer
+	//   - relative-json-pointer
+	//   - regex
+
+	AddWithInfo(Default, "uri", new(URI), WrapValidator(govalidator.IsRequestURI), FormatInfo{
+		Description:     "An absolute URI, as defined by RFC 3986",
+		Examples:        []string{"http://somewhere.com"},
+		InvalidExamples: []string{"somewhere.com"},
+	})
+
+	AddWithInfo(Default, "email", new(Email), WrapValidator(govalidator.IsEmail), FormatInfo{
+		Description:     "An email address, as defined by RFC 5322",
+		Examples:        []string{"somebody@somewhere.com"},
+		InvalidExamples: []string{"somebody@somewhere@com"},
+	})
+
+	AddWithInfo(Default, "hostname", new(Hostname), ValidateHostname, FormatInfo{
+		Description:     "An Internet host name, as defined by RFC 1034",
+		Pattern:         `^[a-zA-Z](([-0-9a-zA-Z]+)?[0-9a-zA-Z])?(\.[a-zA-Z](([-0-9a-zA-Z]+)?[0-9a-zA-Z])?)*$`,
+		MaxLength:       255,
+		Examples:        []string{"somewhere.com"},
+		InvalidExamples: []string{"somewhere.com!"},
+	})
+
+	AddWithInfo(Default, "ipv4", new(IPv4), WrapValidator(govalidator.IsIPv4), FormatInfo{
+		Description:     "An IPv4 address in dotted-quad notation",
+		Pattern:         `^((25[0-5]|2[0-4][0-9]|1[0-9][0-9]|[1-9]?[0-9])\.){3}(25[0-5]|2[0-4][0-9]|1[0-9][0-9]|[1-9]?[0-9])$`,
+		MaxLength:       15,
+		Examples:        []string{"192.168.254.1"},
+		InvalidExamples: []string{"192.168.254.2.2"},
+	})
+
+	AddWithInfo(Default, "ipv6", new(IPv6), WrapValidator(govalidator.IsIPv6), FormatInfo{
+		Description:     "An IPv6 address, as defined by RFC 4291",
+		MaxLength:       45,
+		Examples:        []string{"::1"},
+		InvalidExamples: []string{"127.0.0.1"},
+	})
+
+	AddWithInfo(Default, "mac", new(MAC), WrapValidator(govalidator.IsMAC), FormatInfo{
+		Description:     "A hardware address such as an IEEE 802 MAC-48, EUI-48 or EUI-64 address",
+		Examples:        []string{"01:02:03:04:05:06"},
+		InvalidExamples: []string{"01:02:03:04:05"},
+	})
+
+	AddWithInfo(Default, "uuid", new(UUID), ValidateUUID, FormatInfo{
+		Description:     "A UUID, as defined by RFC 4122, dashes are optional and upper case is allowed",
+		Pattern:         `^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`,
+		MaxLength:       36,
+		Examples:        []string{"a8098c1a-f86e-11da-bd1a-00112444be1e", "2EB8AA08-AA98-11EA-B4AA-73B441D16380", "2eb8aa08-AA98-11ea-B4Aa-73B441D16380", "99c17cbb-656f-f64a-940f-1a4568f03487"},
+		InvalidExamples: []string{"not-a-uuid"},
+	})
+
+	AddWithInfo(Default, "uuid3", new(UUID3), ValidateUUID3, FormatInfo{
+		Description:     "A version 3 (MD5 name based) UUID, as defined by RFC 4122",
+		Pattern:         `^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?3[0-9a-fA-F]{3}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`,
+		MaxLength:       36,
+		Examples:        []string{"bcd02e22-68f0-3046-a512-327cca9def8f"},
+		InvalidExamples: []string{"not-a-uuid"},
+	})
+
+	AddWithInfo(Default, "uuid4", new(UUID4), ValidateUUID4, FormatInfo{
+		Description:     "A version 4 (random) UUID, as defined by RFC 4122",
+		Pattern:         `^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?4[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`,
+		MaxLength:       36,
+		Examples:        []string{"025b0d74-00a2-4048-bf57-227c5111bb34"},
+		InvalidExamples: []string{"not-a-uuid"},
+	})
+
+	AddWithInfo(Default, "uuid5", new(UUID5), ValidateUUID5, FormatInfo{
+		Description:     "A version 5 (SHA-1 name based) UUID, as defined by RFC 4122",
+		Pattern:         `^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?5[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`,
+		MaxLength:       36,
+		Examples:        []string{"886313e1-3b8a-5372-9b90-0c9aee199e5d"},
+		InvalidExamples: []string{"not-a-uuid"},
+	})
+
+	AddWithInfo(Default, "uuid6", new(UUID6), ValidateUUID6, FormatInfo{
+		Description:     "A version 6 (reordered time based) UUID, as defined by RFC 9562",
+		Pattern:         `^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?6[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`,
+		MaxLength:       36,
+		Examples:        []string{"1ec9414c-232a-6b00-b3c8-9f6bdeced846"},
+		InvalidExamples: []string{"not-a-uuid", "1ec9414c-232a-1b00-b3c8-9f6bdeced846"},
+	})
+
+	AddWithInfo(Default, "uuid7", new(UUID7), ValidateUUID7, FormatInfo{
+		Description:     "A version 7 (Unix epoch time based) UUID, as defined by RFC 9562",
+		Pattern:         `^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?7[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`,
+		MaxLength:       36,
+		Examples:        []string{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f"},
+		InvalidExamples: []string{"not-a-uuid", "017f22e2-79b0-7cc3-c8c4-dc0c0c07398f"},
+	})
+
+	AddWithInfo(Default, "uuid8", new(UUID8), ValidateUUID8, FormatInfo{
+		Description:     "A version 8 (custom) UUID, as defined by RFC 9562",
+		Pattern:         `^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?8[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`,
+		MaxLength:       36,
+		Examples:        []string{"2489e9ad-2ee2-8e00-8ec9-32d5f69181c0"},
+		InvalidExamples: []string{"not-a-uuid", "2489e9ad-2ee2-4e00-8ec9-32d5f69181c0"},
+	})
+
+	AddWithInfo(Default, "ulid", new(ULID), ValidateULID, FormatInfo{
+		Description:     "A ULID, 26 digits of the Crockford base32 encoding",
+		Pattern:         `^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`,
+		MaxLength:       26,
+		Examples:        []string{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01arz3ndektsv4rrffq69g5fav", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"},
+		InvalidExamples: []string{"01ARZ3NDEKTSV4RRFFQ69G5FAU", "81ARZ3NDEKTSV4RRFFQ69G5FAV", "01ARZ3NDEKTSV4RRFFQ69G5FA"},
+	})
+
+	AddWithInfo(Default, "ksuid", new(KSUID), ValidateKSUID, FormatInfo{
+		Description:     "A KSUID, 27 digits of the base62 encoding",
+		Pattern:         `^[0-9A-Za-z]{27}$`,
+		MaxLength:       27,
+		Examples:        []string{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", "000000000000000000000000000", "aWgEPTl1tmebfsQzFP4bxwgy80V"},
+		InvalidExamples: []string{"aWgEPTl1tmebfsQzFP4bxwgy80W", "0ujtsYcgvSTl8PAuAdqWYSMnLO", "0ujtsYcgvSTl8PAuAdqWYSMnLO-"},
+	})
+
+	AddWithInfo(Default, "isbn", new(ISBN), WrapValidator(func(str string) bool { return govalidator.IsISBN10(str) || govalidator.IsISBN13(str) }), FormatInfo{
+		Description:     "An ISBN-10 or ISBN-13 book number",
+		Examples:        []string{"0321751043", "978-0321751041"},
+		InvalidExamples: []string{"836217463"},
+	})
+
+	AddWithInfo(Default, "isbn10", new(ISBN10), WrapValidator(govalidator.IsISBN10), FormatInfo{
+		Description:     "An ISBN-10 book number",
+		Examples:        []string{"0321751043"},
+		InvalidExamples: []string{"836217463"},
+	})
+
+	AddWithInfo(Default, "isbn13", new(ISBN13), WrapValidator(govalidator.IsISBN13), FormatInfo{
+		Description:     "An ISBN-13 book number",
+		Examples:        []string{"978-0321751041"},
+		InvalidExamples: []string{"978-0321751042"},
+	})
+
+	AddWithInfo(Default, "creditcard", new(CreditCard), WrapValidator(govalidator.IsCreditCard), FormatInfo{
+		Description:     "A credit card number with a valid checksum",
+		Examples:        []string{"4111-1111-1111-1111"},
+		InvalidExamples: []string{"9999-9999-9999-999"},
+	})
+
+	AddWithInfo(Default, "ssn", new(SSN), WrapValidator(govalidator.IsSSN), FormatInfo{
+		Description:     "A U.S. social security number",
+		Pattern:         `^[0-9]{3}[- ][0-9]{2}[- ][0-9]{4}$`,
+		MaxLength:       11,
+		Examples:        []string{"111-11-1111"},
+		InvalidExamples: []string{"999 99 999"},
+	})
+
+	AddWithInfo(Default, "hexcolor", new(HexColor), WrapValidator(govalidator.IsHexcolor), FormatInfo{
+		Description:     "A hexadecimal color code, such as #FFF or #FFFFFF",
+		Pattern:         `^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`,
+		MaxLength:       7,
+		Examples:        []string{"#FFFFFF"},
+		InvalidExamples: []string{"#fffffffz"},
+	})
+
+	AddWithInfo(Default, "rgbcolor", new(RGBColor), WrapValidator(govalidator.IsRGBcolor), FormatInfo{
+		Description:     "An RGB color, such as rgb(255,255,255)",
+		Pattern:         `^rgb\(\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*,\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*,\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*\)$`,
+		Examples:        []string{"rgb(255,255,255)"},
+		InvalidExamples: []string{"rgb(300,0,0)"},
+	})
+
+	AddWithInfo(Default, "password", new(Password), WrapValidator(func(_ string) bool { return true }), FormatInfo{
+		Description: "A password, not validated and mainly used as a marker for UI components",
+		Examples:    []string{"super secret stuff here"},
+	})
+
+	AddWithInfo(Default, "uri-reference", new(URIReference), ValidateURIReference, FormatInfo{
+		Description:     "A URI or a relative reference, as defined by RFC 3986",
+		Examples:        []string{"http://foo.bar/?baz=qux#quux", "//foo.bar/?baz=qux#quux", "/abc", "#fragment"},
+		InvalidExamples: []string{"\\\\WINDOWS\\fileshare", "#frag\\ment"},
+	})
+
+	AddWithInfo(Default, "iri", new(IRI), ValidateIRI, FormatInfo{
+		Description:     "An internationalized resource identifier, as defined by RFC 3987",
+		Examples:        []string{"http://ƒøø.ßår/?∂éœ=πîx#πîüx", "http://[2001:0db8:85a3:0000:0000:8a2e:0370:7334]"},
+		InvalidExamples: []string{"/abc", "http://2001:0db8:85a3:0000:0000:8a2e:0370:7334"},
+	})
+
+	AddWithInfo(Default, "iri-reference", new(IRIReference), ValidateIRIReference, FormatInfo{
+		Description:     "An IRI or a relative IRI reference, as defined by RFC 3987",
+		Examples:        []string{"http://ƒøø.ßår/?∂éœ=πîx#πîüx", "/âππ", "#ƒrägmênt"},
+		InvalidExamples: []string{"\\\\WINDOWS\\filëßåré", "#ƒräg\\mênt"},
+	})
+
+	AddWithInfo(Default, "idn-email", new(IDNEmail), ValidateIDNEmail, FormatInfo{
+		Description:     "An internationalized email address, as defined by RFC 6531",
+		Examples:        []string{"실례@실례.테스트", "joe.bloggs@example.com"},
+		InvalidExamples: []string{"2962", "joe..bloggs@example.com"},
+	})
+
+	AddWithInfo(Default, "idn-hostname", new(IDNHostname), ValidateIDNHostname, FormatInfo{
+		Description:     "An internationalized Internet host name, as defined by RFC 5890",
+		Examples:        []string{"실례.테스트", "xn--ihqwcrb4cv8a8dqg056pqjye"},
+		InvalidExamples: []string{"〮실례.테스트", "-hello"},
+	})
+
+	AddWithInfo(Default, "uri-template", new(URITemplate), ValidateURITemplate, FormatInfo{
+		Description:     "A URI template, as defined by RFC 6570",
+		Examples:        []string{"http://example.com/dictionary/{term:1}/{term}", "http://example.com/search{?q,lang}"},
+		InvalidExamples: []string{"http://example.com/dictionary/{term:1}/{term"},
+	})
+
+	AddWithInfo(Default, "json-pointer", new(JSONPointer), ValidateJSONPointer, FormatInfo{
+		Description:     "A JSON pointer, as defined by RFC 6901",
+		Pattern:         `^(/([^~]|~[01])*)*$`,
+		Examples:        []string{"/foo/bar~0/baz~1/%a", ""},
+		InvalidExamples: []string{"/foo/bar~", "#/foo"},
+	})
+
+	AddWithInfo(Default, "relative-json-pointer", new(RelativeJSONPointer), ValidateRelativeJSONPointer, FormatInfo{
+		Description:     "A relative JSON pointer, as defined by draft-handrews-relative-json-pointer-01",
+		Pattern:         `^(0|[1-9][0-9]*)(#|(/([^~]|~[01])*)*)$`,
+		Examples:        []string{"1", "0/foo/bar", "0#"},
+		InvalidExamples: []string{"/foo/bar", "01#"},
+	})
+
+	AddWithInfo(Default, "regex", new(Regex), ValidateRegex, FormatInfo{
+		Description:     "A regular expression, in the ECMA-262 dialect",
+		Examples:        []string{"([abc])+\\s+$", "^(?<year>[0-9]{4})-\\k<year>$"},
+		InvalidExamples: []string{"^(abc]", "a{2,1}"},
+	})
+}
+
+// URI represents the uri string format as specified by the json schema spec
+//
+// swagger:strfmt uri
+type URI string
+
+// MarshalText turns this instance into text
+func (u URI) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *URI) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = URI(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *URI) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = URI(string(v))
+	case string:
+		*u = URI(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.URI from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u URI) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u URI) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the URI as JSON
+func (u URI) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the URI to a easyjson.Writer
+func (u URI) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the URI from JSON
+func (u *URI) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the URI from a easyjson.Lexer
+func (u *URI) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = URI(data)
+	}
+}
+
+// GetBSON returns the URI as a bson.M{} map.
+func (u *URI) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the URI from raw bson data
+func (u *URI) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = URI(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as URI")
+}
+
+// Email represents the email string format as specified by the json schema spec
+//
+// swagger:strfmt email
+type Email string
+
+// MarshalText turns this instance into text
+func (e Email) MarshalText() ([]byte, error) {
+	return []byte(string(e)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (e *Email) UnmarshalText(data []byte) error { // validation is performed later on
+	*e = Email(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (e *Email) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*e = Email(string(v))
+	case string:
+		*e = Email(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Email from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (e Email) Value() (driver.Value, error) {
+	return driver.Value(string(e)), nil
+}
+
+func (e Email) String() string {
+	return string(e)
+}
+
+// MarshalJSON returns the Email as JSON
+func (e Email) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	e.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the Email to a easyjson.Writer
+func (e Email) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(e))
+}
+
+// UnmarshalJSON sets the Email from JSON
+func (e *Email) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	e.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the Email from a easyjson.Lexer
+func (e *Email) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*e = Email(data)
+	}
+}
+
+// GetBSON returns the Email as a bson.M{} map.
+func (e *Email) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*e)}, nil
+}
+
+// SetBSON sets the Email from raw bson data
+func (e *Email) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*e = Email(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as Email")
+}
+
+// Hostname represents the hostname string format as specified by the json schema spec
+//
+// swagger:strfmt hostname
+type Hostname string
+
+// MarshalText turns this instance into text
+func (h Hostname) MarshalText() ([]byte, error) {
+	return []byte(string(h)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (h *Hostname) UnmarshalText(data []byte) error { // validation is performed later on
+	*h = Hostname(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (h *Hostname) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*h = Hostname(string(v))
+	case string:
+		*h = Hostname(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Hostname from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (h Hostname) Value() (driver.Value, error) {
+	return driver.Value(string(h)), nil
+}
+
+func (h Hostname) String() string {
+	return string(h)
+}
+
+// MarshalJSON returns the Hostname as JSON
+func (h Hostname) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	h.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the Hostname to a easyjson.Writer
+func (h Hostname) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(h))
+}
+
+// UnmarshalJSON sets the Hostname from JSON
+func (h *Hostname) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	h.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the Hostname from a easyjson.Lexer
+func (h *Hostname) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*h = Hostname(data)
+	}
+}
+
+// GetBSON returns the Hostname as a bson.M{} map.
+func (h *Hostname) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*h)}, nil
+}
+
+// SetBSON sets the Hostname from raw bson data
+func (h *Hostname) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*h = Hostname(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as Hostname")
+}
+
+// IPv4 represents an IP v4 address
+//
+// swagger:strfmt ipv4
+type IPv4 string
+
+// MarshalText turns this instance into text
+func (u IPv4) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *IPv4) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = IPv4(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *IPv4) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = IPv4(string(v))
+	case string:
+		*u = IPv4(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IPv4 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u IPv4) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u IPv4) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the IPv4 as JSON
+func (u IPv4) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the IPv4 to a easyjson.Writer
+func (u IPv4) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the IPv4 from JSON
+func (u *IPv4) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the IPv4 from a easyjson.Lexer
+func (u *IPv4) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = IPv4(data)
+	}
+}
+
+// GetBSON returns the IPv4 as a bson.M{} map.
+func (u *IPv4) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the IPv4 from raw bson data
+func (u *IPv4) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = IPv4(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as IPv4")
+}
+
+// IPv6 represents an IP v6 address
+//
+// swagger:strfmt ipv6
+type IPv6 string
+
+// MarshalText turns this instance into text
+func (u IPv6) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *IPv6) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = IPv6(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *IPv6) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = IPv6(string(v))
+	case string:
+		*u = IPv6(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IPv6 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u IPv6) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u IPv6) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the IPv6 as JSON
+func (u IPv6) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the IPv6 to a easyjson.Writer
+func (u IPv6) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the IPv6 from JSON
+func (u *IPv6) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the IPv6 from a easyjson.Lexer
+func (u *IPv6) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = IPv6(data)
+	}
+}
+
+// GetBSON returns the IPv6 as a bson.M{} map.
+func (u *IPv6) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the IPv6 from raw bson data
+func (u *IPv6) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = IPv6(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as IPv6")
+}
+
+// MAC represents a 48 bit MAC address
+//
+// swagger:strfmt mac
+type MAC string
+
+// MarshalText turns this instance into text
+func (u MAC) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *MAC) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = MAC(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *MAC) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = MAC(string(v))
+	case string:
+		*u = MAC(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.MAC from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u MAC) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u MAC) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the MAC as JSON
+func (u MAC) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the MAC to a easyjson.Writer
+func (u MAC) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the MAC from JSON
+func (u *MAC) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the MAC from a easyjson.Lexer
+func (u *MAC) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = MAC(data)
+	}
+}
+
+// GetBSON returns the MAC as a bson.M{} map.
+func (u *MAC) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the MAC from raw bson data
+func (u *MAC) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = MAC(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as MAC")
+}
+
+// UUID represents a uuid string format
+//
+// swagger:strfmt uuid
+type UUID string
+
+// MarshalText turns this instance into text
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *UUID) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = UUID(uuidText(v))
+	case string:
+		*u = UUID(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID as JSON
+func (u UUID) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the UUID to a easyjson.Writer
+func (u UUID) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the UUID from JSON
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the UUID from a easyjson.Lexer
+func (u *UUID) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = UUID(data)
+	}
+}
+
+// GetBSON returns the UUID as a bson.M{} map.
+func (u *UUID) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the UUID from raw bson data
+func (u *UUID) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = UUID(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as UUID")
+}
+
+// Bytes returns the 16 bytes of the UUID, all zero when it isn't a valid UUID
+func (u UUID) Bytes() [16]byte {
+	b, _ := uuidBytes(string(u))
+	return b
+}
+
+// Canonical returns the UUID in lower case with dashes, unchanged when it isn't a valid UUID
+func (u UUID) Canonical() UUID {
+	return UUID(canonicalUUID(string(u)))
+}
+
+// Version returns the version of the UUID, from the first digit of its third group
+func (u UUID) Version() int {
+	return uuidVersion(string(u))
+}
+
+// Variant returns the variant of the UUID, from the first digit of its fourth group
+func (u UUID) Variant() UUIDVariant {
+	return uuidVariant(string(u))
+}
+
+func (u *UUID) canonicalize() {
+	*u = u.Canonical()
+}
+
+// UUID3 represents a uuid3 string format
+//
+// swagger:strfmt uuid3
+type UUID3 string
+
+// MarshalText turns this instance into text
+func (u UUID3) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID3) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID3(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *UUID3) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = UUID3(uuidText(v))
+	case string:
+		*u = UUID3(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID3 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID3) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID3) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID3 as JSON
+func (u UUID3) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the UUID3 to a easyjson.Writer
+func (u UUID3) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the UUID3 from JSON
+func (u *UUID3) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the UUID3 from a easyjson.Lexer
+func (u *UUID3) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = UUID3(data)
+	}
+}
+
+// GetBSON returns the UUID3 as a bson.M{} map.
+func (u *UUID3) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the UUID3 from raw bson data
+func (u *UUID3) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = UUID3(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as UUID3")
+}
+
+// Bytes returns the 16 bytes of the UUID, all zero when it isn't a valid UUID
+func (u UUID3) Bytes() [16]byte {
+	b, _ := uuidBytes(string(u))
+	return b
+}
+
+// Canonical returns the UUID in lower case with dashes, unchanged when it isn't a valid UUID
+func (u UUID3) Canonical() UUID3 {
+	return UUID3(canonicalUUID(string(u)))
+}
+
+// Version returns the version of the UUID, from the first digit of its third group
+func (u UUID3) Version() int {
+	return uuidVersion(string(u))
+}
+
+// Variant returns the variant of the UUID, from the first digit of its fourth group
+func (u UUID3) Variant() UUIDVariant {
+	return uuidVariant(string(u))
+}
+
+func (u *UUID3) canonicalize() {
+	*u = u.Canonical()
+}
+
+// UUID4 represents a uuid4 string format
+//
+// swagger:strfmt uuid4
+type UUID4 string
+
+// MarshalText turns this instance into text
+func (u UUID4) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID4) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID4(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *UUID4) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = UUID4(uuidText(v))
+	case string:
+		*u = UUID4(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID4 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID4) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID4) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID4 as JSON
+func (u UUID4) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the UUID4 to a easyjson.Writer
+func (u UUID4) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the UUID4 from JSON
+func (u *UUID4) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the UUID4 from a easyjson.Lexer
+func (u *UUID4) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = UUID4(data)
+	}
+}
+
+// GetBSON returns the UUID4 as a bson.M{} map.
+func (u *UUID4) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the UUID4 from raw bson data
+func (u *UUID4) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = UUID4(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as UUID4")
+}
+
+// Bytes returns the 16 bytes of the UUID, all zero when it isn't a valid UUID
+func (u UUID4) Bytes() [16]byte {
+	b, _ := uuidBytes(string(u))
+	return b
+}
+
+// Canonical returns the UUID in lower case with dashes, unchanged when it isn't a valid UUID
+func (u UUID4) Canonical() UUID4 {
+	return UUID4(canonicalUUID(string(u)))
+}
+
+// Version returns the version of the UUID, from the first digit of its third group
+func (u UUID4) Version() int {
+	return uuidVersion(string(u))
+}
+
+// Variant returns the variant of the UUID, from the first digit of its fourth group
+func (u UUID4) Variant() UUIDVariant {
+	return uuidVariant(string(u))
+}
+
+func (u *UUID4) canonicalize() {
+	*u = u.Canonical()
+}
+
+// UUID5 represents a uuid5 string format
+//
+// swagger:strfmt uuid5
+type UUID5 string
+
+// MarshalText turns this instance into text
+func (u UUID5) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID5) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID5(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *UUID5) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = UUID5(uuidText(v))
+	case string:
+		*u = UUID5(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID5 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID5) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID5) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID5 as JSON
+func (u UUID5) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the UUID5 to a easyjson.Writer
+func (u UUID5) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the UUID5 from JSON
+func (u *UUID5) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the UUID5 from a easyjson.Lexer
+func (u *UUID5) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = UUID5(data)
+	}
+}
+
+// GetBSON returns the UUID5 as a bson.M{} map.
+func (u *UUID5) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the UUID5 from raw bson data
+func (u *UUID5) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = UUID5(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as UUID5")
+}
+
+// Bytes returns the 16 bytes of the UUID, all zero when it isn't a valid UUID
+func (u UUID5) Bytes() [16]byte {
+	b, _ := uuidBytes(string(u))
+	return b
+}
+
+// Canonical returns the UUID in lower case with dashes, unchanged when it isn't a valid UUID
+func (u UUID5) Canonical() UUID5 {
+	return UUID5(canonicalUUID(string(u)))
+}
+
+// Version returns the version of the UUID, from the first digit of its third group
+func (u UUID5) Version() int {
+	return uuidVersion(string(u))
+}
+
+// Variant returns the variant of the UUID, from the first digit of its fourth group
+func (u UUID5) Variant() UUIDVariant {
+	return uuidVariant(string(u))
+}
+
+func (u *UUID5) canonicalize() {
+	*u = u.Canonical()
+}
+
+// UUID6 represents a uuid6 string format
+//
+// swagger:strfmt uuid6
+type UUID6 string
+
+// MarshalText turns this instance into text
+func (u UUID6) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID6) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID6(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *UUID6) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = UUID6(uuidText(v))
+	case string:
+		*u = UUID6(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID6 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID6) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID6) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID6 as JSON
+func (u UUID6) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the UUID6 to a easyjson.Writer
+func (u UUID6) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the UUID6 from JSON
+func (u *UUID6) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the UUID6 from a easyjson.Lexer
+func (u *UUID6) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = UUID6(data)
+	}
+}
+
+// GetBSON returns the UUID6 as a bson.M{} map.
+func (u *UUID6) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the UUID6 from raw bson data
+func (u *UUID6) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = UUID6(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as UUID6")
+}
+
+// Bytes returns the 16 bytes of the UUID, all zero when it isn't a valid UUID
+func (u UUID6) Bytes() [16]byte {
+	b, _ := uuidBytes(string(u))
+	return b
+}
+
+// Canonical returns the UUID in lower case with dashes, unchanged when it isn't a valid UUID
+func (u UUID6) Canonical() UUID6 {
+	return UUID6(canonicalUUID(string(u)))
+}
+
+// Version returns the version of the UUID, from the first digit of its third group
+func (u UUID6) Version() int {
+	return uuidVersion(string(u))
+}
+
+// Variant returns the variant of the UUID, from the first digit of its fourth group
+func (u UUID6) Variant() UUIDVariant {
+	return uuidVariant(string(u))
+}
+
+func (u *UUID6) canonicalize() {
+	*u = u.Canonical()
+}
+
+// UUID7 represents a uuid7 string format
+//
+// swagger:strfmt uuid7
+type UUID7 string
+
+// MarshalText turns this instance into text
+func (u UUID7) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID7) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID7(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *UUID7) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = UUID7(uuidText(v))
+	case string:
+		*u = UUID7(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID7 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID7) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID7) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID7 as JSON
+func (u UUID7) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the UUID7 to a easyjson.Writer
+func (u UUID7) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the UUID7 from JSON
+func (u *UUID7) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the UUID7 from a easyjson.Lexer
+func (u *UUID7) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = UUID7(data)
+	}
+}
+
+// GetBSON returns the UUID7 as a bson.M{} map.
+func (u *UUID7) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the UUID7 from raw bson data
+func (u *UUID7) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = UUID7(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as UUID7")
+}
+
+// Bytes returns the 16 bytes of the UUID, all zero when it isn't a valid UUID
+func (u UUID7) Bytes() [16]byte {
+	b, _ := uuidBytes(string(u))
+	return b
+}
+
+// Canonical returns the UUID in lower case with dashes, unchanged when it isn't a valid UUID
+func (u UUID7) Canonical() UUID7 {
+	return UUID7(canonicalUUID(string(u)))
+}
+
+// Version returns the version of the UUID, from the first digit of its third group
+func (u UUID7) Version() int {
+	return uuidVersion(string(u))
+}
+
+// Variant returns the variant of the UUID, from the first digit of its fourth group
+func (u UUID7) Variant() UUIDVariant {
+	return uuidVariant(string(u))
+}
+
+func (u *UUID7) canonicalize() {
+	*u = u.Canonical()
+}
+
+// UUID8 represents a uuid8 string format
+//
+// swagger:strfmt uuid8
+type UUID8 string
+
+// MarshalText turns this instance into text
+func (u UUID8) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *UUID8) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = UUID8(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *UUID8) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = UUID8(uuidText(v))
+	case string:
+		*u = UUID8(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.UUID8 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u UUID8) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u UUID8) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the UUID8 as JSON
+func (u UUID8) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the UUID8 to a easyjson.Writer
+func (u UUID8) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the UUID8 from JSON
+func (u *UUID8) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the UUID8 from a easyjson.Lexer
+func (u *UUID8) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = UUID8(data)
+	}
+}
+
+// GetBSON returns the UUID8 as a bson.M{} map.
+func (u *UUID8) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the UUID8 from raw bson data
+func (u *UUID8) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = UUID8(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as UUID8")
+}
+
+// Bytes returns the 16 bytes of the UUID, all zero when it isn't a valid UUID
+func (u UUID8) Bytes() [16]byte {
+	b, _ := uuidBytes(string(u))
+	return b
+}
+
+// Canonical returns the UUID in lower case with dashes, unchanged when it isn't a valid UUID
+func (u UUID8) Canonical() UUID8 {
+	return UUID8(canonicalUUID(string(u)))
+}
+
+// Version returns the version of the UUID, from the first digit of its third group
+func (u UUID8) Version() int {
+	return uuidVersion(string(u))
+}
+
+// Variant returns the variant of the UUID, from the first digit of its fourth group
+func (u UUID8) Variant() UUIDVariant {
+	return uuidVariant(string(u))
+}
+
+func (u *UUID8) canonicalize() {
+	*u = u.Canonical()
+}
+
+// ULID represents a universally unique lexicographically sortable identifier
+//
+// swagger:strfmt ulid
+type ULID string
+
+// MarshalText turns this instance into text
+func (u ULID) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *ULID) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = ULID(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *ULID) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = ULID(string(v))
+	case string:
+		*u = ULID(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.ULID from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u ULID) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u ULID) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the ULID as JSON
+func (u ULID) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the ULID to a easyjson.Writer
+func (u ULID) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the ULID from JSON
+func (u *ULID) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the ULID from a easyjson.Lexer
+func (u *ULID) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = ULID(data)
+	}
+}
+
+// GetBSON returns the ULID as a bson.M{} map.
+func (u *ULID) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the ULID from raw bson data
+func (u *ULID) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = ULID(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as ULID")
+}
+
+// KSUID represents a K-sortable unique identifier
+//
+// swagger:strfmt ksuid
+type KSUID string
+
+// MarshalText turns this instance into text
+func (k KSUID) MarshalText() ([]byte, error) {
+	return []byte(string(k)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (k *KSUID) UnmarshalText(data []byte) error { // validation is performed later on
+	*k = KSUID(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (k *KSUID) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*k = KSUID(string(v))
+	case string:
+		*k = KSUID(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.KSUID from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (k KSUID) Value() (driver.Value, error) {
+	return driver.Value(string(k)), nil
+}
+
+func (k KSUID) String() string {
+	return string(k)
+}
+
+// MarshalJSON returns the KSUID as JSON
+func (k KSUID) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	k.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the KSUID to a easyjson.Writer
+func (k KSUID) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(k))
+}
+
+// UnmarshalJSON sets the KSUID from JSON
+func (k *KSUID) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	k.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the KSUID from a easyjson.Lexer
+func (k *KSUID) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*k = KSUID(data)
+	}
+}
+
+// GetBSON returns the KSUID as a bson.M{} map.
+func (k *KSUID) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*k)}, nil
+}
+
+// SetBSON sets the KSUID from raw bson data
+func (k *KSUID) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*k = KSUID(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as KSUID")
+}
+
+// ISBN represents an isbn string format
+//
+// swagger:strfmt isbn
+type ISBN string
+
+// MarshalText turns this instance into text
+func (u ISBN) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *ISBN) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = ISBN(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *ISBN) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = ISBN(string(v))
+	case string:
+		*u = ISBN(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.ISBN from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u ISBN) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u ISBN) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the ISBN as JSON
+func (u ISBN) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the ISBN to a easyjson.Writer
+func (u ISBN) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the ISBN from JSON
+func (u *ISBN) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the ISBN from a easyjson.Lexer
+func (u *ISBN) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = ISBN(data)
+	}
+}
+
+// GetBSON returns the ISBN as a bson.M{} map.
+func (u *ISBN) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the ISBN from raw bson data
+func (u *ISBN) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = ISBN(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as ISBN")
+}
+
+// ISBN10 represents an isbn 10 string format
+//
+// swagger:strfmt isbn10
+type ISBN10 string
+
+// MarshalText turns this instance into text
+func (u ISBN10) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *ISBN10) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = ISBN10(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *ISBN10) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = ISBN10(string(v))
+	case string:
+		*u = ISBN10(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.ISBN10 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u ISBN10) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u ISBN10) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the ISBN10 as JSON
+func (u ISBN10) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the ISBN10 to a easyjson.Writer
+func (u ISBN10) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the ISBN10 from JSON
+func (u *ISBN10) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the ISBN10 from a easyjson.Lexer
+func (u *ISBN10) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = ISBN10(data)
+	}
+}
+
+// GetBSON returns the ISBN10 as a bson.M{} map.
+func (u *ISBN10) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the ISBN10 from raw bson data
+func (u *ISBN10) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = ISBN10(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as ISBN10")
+}
+
+// ISBN13 represents an isbn 13 string format
+//
+// swagger:strfmt isbn13
+type ISBN13 string
+
+// MarshalText turns this instance into text
+func (u ISBN13) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *ISBN13) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = ISBN13(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *ISBN13) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = ISBN13(string(v))
+	case string:
+		*u = ISBN13(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.ISBN13 from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u ISBN13) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u ISBN13) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the ISBN13 as JSON
+func (u ISBN13) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the ISBN13 to a easyjson.Writer
+func (u ISBN13) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the ISBN13 from JSON
+func (u *ISBN13) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the ISBN13 from a easyjson.Lexer
+func (u *ISBN13) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = ISBN13(data)
+	}
+}
+
+// GetBSON returns the ISBN13 as a bson.M{} map.
+func (u *ISBN13) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the ISBN13 from raw bson data
+func (u *ISBN13) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = ISBN13(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as ISBN13")
+}
+
+// CreditCard represents a credit card string format
+//
+// swagger:strfmt creditcard
+type CreditCard string
+
+// MarshalText turns this instance into text
+func (u CreditCard) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *CreditCard) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = CreditCard(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *CreditCard) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = CreditCard(string(v))
+	case string:
+		*u = CreditCard(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.CreditCard from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u CreditCard) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u CreditCard) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the CreditCard as JSON
+func (u CreditCard) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the CreditCard to a easyjson.Writer
+func (u CreditCard) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the CreditCard from JSON
+func (u *CreditCard) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the CreditCard from a easyjson.Lexer
+func (u *CreditCard) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = CreditCard(data)
+	}
+}
+
+// GetBSON returns the CreditCard as a bson.M{} map.
+func (u *CreditCard) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the CreditCard from raw bson data
+func (u *CreditCard) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = CreditCard(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as CreditCard")
+}
+
+// SSN represents a social security string format
+//
+// swagger:strfmt ssn
+type SSN string
+
+// MarshalText turns this instance into text
+func (u SSN) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *SSN) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = SSN(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *SSN) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = SSN(string(v))
+	case string:
+		*u = SSN(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.SSN from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u SSN) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u SSN) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the SSN as JSON
+func (u SSN) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the SSN to a easyjson.Writer
+func (u SSN) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the SSN from JSON
+func (u *SSN) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the SSN from a easyjson.Lexer
+func (u *SSN) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = SSN(data)
+	}
+}
+
+// GetBSON returns the SSN as a bson.M{} map.
+func (u *SSN) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the SSN from raw bson data
+func (u *SSN) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = SSN(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as SSN")
+}
+
+// HexColor represents a hex color string format
+//
+// swagger:strfmt hexcolor
+type HexColor string
+
+// MarshalText turns this instance into text
+func (h HexColor) MarshalText() ([]byte, error) {
+	return []byte(string(h)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (h *HexColor) UnmarshalText(data []byte) error { // validation is performed later on
+	*h = HexColor(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (h *HexColor) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*h = HexColor(string(v))
+	case string:
+		*h = HexColor(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.HexColor from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (h HexColor) Value() (driver.Value, error) {
+	return driver.Value(string(h)), nil
+}
+
+func (h HexColor) String() string {
+	return string(h)
+}
+
+// MarshalJSON returns the HexColor as JSON
+func (h HexColor) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	h.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the HexColor to a easyjson.Writer
+func (h HexColor) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(h))
+}
+
+// UnmarshalJSON sets the HexColor from JSON
+func (h *HexColor) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	h.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the HexColor from a easyjson.Lexer
+func (h *HexColor) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*h = HexColor(data)
+	}
+}
+
+// GetBSON returns the HexColor as a bson.M{} map.
+func (h *HexColor) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*h)}, nil
+}
+
+// SetBSON sets the HexColor from raw bson data
+func (h *HexColor) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*h = HexColor(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as HexColor")
+}
+
+// RGBColor represents a RGB color string format
+//
+// swagger:strfmt rgbcolor
+type RGBColor string
+
+// MarshalText turns this instance into text
+func (r RGBColor) MarshalText() ([]byte, error) {
+	return []byte(string(r)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (r *RGBColor) UnmarshalText(data []byte) error { // validation is performed later on
+	*r = RGBColor(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (r *RGBColor) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*r = RGBColor(string(v))
+	case string:
+		*r = RGBColor(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.RGBColor from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (r RGBColor) Value() (driver.Value, error) {
+	return driver.Value(string(r)), nil
+}
+
+func (r RGBColor) String() string {
+	return string(r)
+}
+
+// MarshalJSON returns the RGBColor as JSON
+func (r RGBColor) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	r.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the RGBColor to a easyjson.Writer
+func (r RGBColor) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(r))
+}
+
+// UnmarshalJSON sets the RGBColor from JSON
+func (r *RGBColor) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	r.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the RGBColor from a easyjson.Lexer
+func (r *RGBColor) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*r = RGBColor(data)
+	}
+}
+
+// GetBSON returns the RGBColor as a bson.M{} map.
+func (r *RGBColor) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*r)}, nil
+}
+
+// SetBSON sets the RGBColor from raw bson data
+func (r *RGBColor) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*r = RGBColor(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as RGBColor")
+}
+
+// Password represents a password.
+// This has no validations and is mainly used as a marker for UI components.
+//
+// swagger:strfmt password
+type Password string
+
+// MarshalText turns this instance into text
+func (r Password) MarshalText() ([]byte, error) {
+	return []byte(string(r)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (r *Password) UnmarshalText(data []byte) error { // validation is performed later on
+	*r = Password(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (r *Password) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*r = Password(string(v))
+	case string:
+		*r = Password(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Password from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (r Password) Value() (driver.Value, error) {
+	return driver.Value(string(r)), nil
+}
+
+func (r Password) String() string {
+	return string(r)
+}
+
+// MarshalJSON returns the Password as JSON
+func (r Password) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	r.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the Password to a easyjson.Writer
+func (r Password) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(r))
+}
+
+// UnmarshalJSON sets the Password from JSON
+func (r *Password) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	r.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the Password from a easyjson.Lexer
+func (r *Password) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*r = Password(data)
+	}
+}
+
+// GetBSON returns the Password as a bson.M{} map.
+func (r *Password) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*r)}, nil
+}
+
+// SetBSON sets the Password from raw bson data
+func (r *Password) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*r = Password(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as Password")
+}
+
+// URIReference represents the uri-reference string format as specified by the json schema spec
+//
+// swagger:strfmt uri-reference
+type URIReference string
+
+// MarshalText turns this instance into text
+func (u URIReference) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *URIReference) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = URIReference(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *URIReference) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = URIReference(string(v))
+	case string:
+		*u = URIReference(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.URIReference from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u URIReference) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u URIReference) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the URIReference as JSON
+func (u URIReference) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the URIReference to a easyjson.Writer
+func (u URIReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the URIReference from JSON
+func (u *URIReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the URIReference from a easyjson.Lexer
+func (u *URIReference) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = URIReference(data)
+	}
+}
+
+// GetBSON returns the URIReference as a bson.M{} map.
+func (u *URIReference) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the URIReference from raw bson data
+func (u *URIReference) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = URIReference(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as URIReference")
+}
+
+// IRI represents the iri string format as specified by the json schema spec
+//
+// swagger:strfmt iri
+type IRI string
+
+// MarshalText turns this instance into text
+func (i IRI) MarshalText() ([]byte, error) {
+	return []byte(string(i)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (i *IRI) UnmarshalText(data []byte) error { // validation is performed later on
+	*i = IRI(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (i *IRI) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*i = IRI(string(v))
+	case string:
+		*i = IRI(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IRI from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (i IRI) Value() (driver.Value, error) {
+	return driver.Value(string(i)), nil
+}
+
+func (i IRI) String() string {
+	return string(i)
+}
+
+// MarshalJSON returns the IRI as JSON
+func (i IRI) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	i.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the IRI to a easyjson.Writer
+func (i IRI) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(i))
+}
+
+// UnmarshalJSON sets the IRI from JSON
+func (i *IRI) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	i.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the IRI from a easyjson.Lexer
+func (i *IRI) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*i = IRI(data)
+	}
+}
+
+// GetBSON returns the IRI as a bson.M{} map.
+func (i *IRI) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*i)}, nil
+}
+
+// SetBSON sets the IRI from raw bson data
+func (i *IRI) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*i = IRI(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as IRI")
+}
+
+// IRIReference represents the iri-reference string format as specified by the json schema spec
+//
+// swagger:strfmt iri-reference
+type IRIReference string
+
+// MarshalText turns this instance into text
+func (i IRIReference) MarshalText() ([]byte, error) {
+	return []byte(string(i)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (i *IRIReference) UnmarshalText(data []byte) error { // validation is performed later on
+	*i = IRIReference(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (i *IRIReference) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*i = IRIReference(string(v))
+	case string:
+		*i = IRIReference(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IRIReference from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (i IRIReference) Value() (driver.Value, error) {
+	return driver.Value(string(i)), nil
+}
+
+func (i IRIReference) String() string {
+	return string(i)
+}
+
+// MarshalJSON returns the IRIReference as JSON
+func (i IRIReference) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	i.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the IRIReference to a easyjson.Writer
+func (i IRIReference) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(i))
+}
+
+// UnmarshalJSON sets the IRIReference from JSON
+func (i *IRIReference) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	i.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the IRIReference from a easyjson.Lexer
+func (i *IRIReference) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*i = IRIReference(data)
+	}
+}
+
+// GetBSON returns the IRIReference as a bson.M{} map.
+func (i *IRIReference) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*i)}, nil
+}
+
+// SetBSON sets the IRIReference from raw bson data
+func (i *IRIReference) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*i = IRIReference(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as IRIReference")
+}
+
+// IDNEmail represents the idn-email string format as specified by the json schema spec
+//
+// swagger:strfmt idn-email
+type IDNEmail string
+
+// MarshalText turns this instance into text
+func (e IDNEmail) MarshalText() ([]byte, error) {
+	return []byte(string(e)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (e *IDNEmail) UnmarshalText(data []byte) error { // validation is performed later on
+	*e = IDNEmail(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (e *IDNEmail) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*e = IDNEmail(string(v))
+	case string:
+		*e = IDNEmail(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IDNEmail from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (e IDNEmail) Value() (driver.Value, error) {
+	return driver.Value(string(e)), nil
+}
+
+func (e IDNEmail) String() string {
+	return string(e)
+}
+
+// MarshalJSON returns the IDNEmail as JSON
+func (e IDNEmail) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	e.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the IDNEmail to a easyjson.Writer
+func (e IDNEmail) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(e))
+}
+
+// UnmarshalJSON sets the IDNEmail from JSON
+func (e *IDNEmail) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	e.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the IDNEmail from a easyjson.Lexer
+func (e *IDNEmail) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*e = IDNEmail(data)
+	}
+}
+
+// GetBSON returns the IDNEmail as a bson.M{} map.
+func (e *IDNEmail) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*e)}, nil
+}
+
+// SetBSON sets the IDNEmail from raw bson data
+func (e *IDNEmail) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*e = IDNEmail(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as IDNEmail")
+}
+
+// IDNHostname represents the idn-hostname string format as specified by the json schema spec
+//
+// swagger:strfmt idn-hostname
+type IDNHostname string
+
+// MarshalText turns this instance into text
+func (h IDNHostname) MarshalText() ([]byte, error) {
+	return []byte(string(h)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (h *IDNHostname) UnmarshalText(data []byte) error { // validation is performed later on
+	*h = IDNHostname(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (h *IDNHostname) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*h = IDNHostname(string(v))
+	case string:
+		*h = IDNHostname(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IDNHostname from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (h IDNHostname) Value() (driver.Value, error) {
+	return driver.Value(string(h)), nil
+}
+
+func (h IDNHostname) String() string {
+	return string(h)
+}
+
+// MarshalJSON returns the IDNHostname as JSON
+func (h IDNHostname) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	h.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the IDNHostname to a easyjson.Writer
+func (h IDNHostname) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(h))
+}
+
+// UnmarshalJSON sets the IDNHostname from JSON
+func (h *IDNHostname) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	h.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the IDNHostname from a easyjson.Lexer
+func (h *IDNHostname) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*h = IDNHostname(data)
+	}
+}
+
+// GetBSON returns the IDNHostname as a bson.M{} map.
+func (h *IDNHostname) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*h)}, nil
+}
+
+// SetBSON sets the IDNHostname from raw bson data
+func (h *IDNHostname) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*h = IDNHostname(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as IDNHostname")
+}
+
+// URITemplate represents the uri-template string format as specified by the json schema spec
+//
+// swagger:strfmt uri-template
+type URITemplate string
+
+// MarshalText turns this instance into text
+func (u URITemplate) MarshalText() ([]byte, error) {
+	return []byte(string(u)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (u *URITemplate) UnmarshalText(data []byte) error { // validation is performed later on
+	*u = URITemplate(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (u *URITemplate) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*u = URITemplate(string(v))
+	case string:
+		*u = URITemplate(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.URITemplate from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (u URITemplate) Value() (driver.Value, error) {
+	return driver.Value(string(u)), nil
+}
+
+func (u URITemplate) String() string {
+	return string(u)
+}
+
+// MarshalJSON returns the URITemplate as JSON
+func (u URITemplate) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	u.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the URITemplate to a easyjson.Writer
+func (u URITemplate) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(u))
+}
+
+// UnmarshalJSON sets the URITemplate from JSON
+func (u *URITemplate) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	u.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the URITemplate from a easyjson.Lexer
+func (u *URITemplate) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*u = URITemplate(data)
+	}
+}
+
+// GetBSON returns the URITemplate as a bson.M{} map.
+func (u *URITemplate) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*u)}, nil
+}
+
+// SetBSON sets the URITemplate from raw bson data
+func (u *URITemplate) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*u = URITemplate(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as URITemplate")
+}
+
+// JSONPointer represents the json-pointer string format as specified by the json schema spec
+//
+// swagger:strfmt json-pointer
+type JSONPointer string
+
+// MarshalText turns this instance into text
+func (p JSONPointer) MarshalText() ([]byte, error) {
+	return []byte(string(p)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (p *JSONPointer) UnmarshalText(data []byte) error { // validation is performed later on
+	*p = JSONPointer(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (p *JSONPointer) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*p = JSONPointer(string(v))
+	case string:
+		*p = JSONPointer(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.JSONPointer from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (p JSONPointer) Value() (driver.Value, error) {
+	return driver.Value(string(p)), nil
+}
+
+func (p JSONPointer) String() string {
+	return string(p)
+}
+
+// MarshalJSON returns the JSONPointer as JSON
+func (p JSONPointer) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	p.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the JSONPointer to a easyjson.Writer
+func (p JSONPointer) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(p))
+}
+
+// UnmarshalJSON sets the JSONPointer from JSON
+func (p *JSONPointer) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	p.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the JSONPointer from a easyjson.Lexer
+func (p *JSONPointer) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*p = JSONPointer(data)
+	}
+}
+
+// GetBSON returns the JSONPointer as a bson.M{} map.
+func (p *JSONPointer) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*p)}, nil
+}
+
+// SetBSON sets the JSONPointer from raw bson data
+func (p *JSONPointer) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*p = JSONPointer(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as JSONPointer")
+}
+
+// RelativeJSONPointer represents the relative-json-pointer string format as specified by the json schema spec
+//
+// swagger:strfmt relative-json-pointer
+type RelativeJSONPointer string
+
+// MarshalText turns this instance into text
+func (p RelativeJSONPointer) MarshalText() ([]byte, error) {
+	return []byte(string(p)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (p *RelativeJSONPointer) UnmarshalText(data []byte) error { // validation is performed later on
+	*p = RelativeJSONPointer(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (p *RelativeJSONPointer) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*p = RelativeJSONPointer(string(v))
+	case string:
+		*p = RelativeJSONPointer(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.RelativeJSONPointer from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (p RelativeJSONPointer) Value() (driver.Value, error) {
+	return driver.Value(string(p)), nil
+}
+
+func (p RelativeJSONPointer) String() string {
+	return string(p)
+}
+
+// MarshalJSON returns the RelativeJSONPointer as JSON
+func (p RelativeJSONPointer) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	p.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the RelativeJSONPointer to a easyjson.Writer
+func (p RelativeJSONPointer) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(p))
+}
+
+// UnmarshalJSON sets the RelativeJSONPointer from JSON
+func (p *RelativeJSONPointer) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	p.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the RelativeJSONPointer from a easyjson.Lexer
+func (p *RelativeJSONPointer) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*p = RelativeJSONPointer(data)
+	}
+}
+
+// GetBSON returns the RelativeJSONPointer as a bson.M{} map.
+func (p *RelativeJSONPointer) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*p)}, nil
+}
+
+// SetBSON sets the RelativeJSONPointer from raw bson data
+func (p *RelativeJSONPointer) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*p = RelativeJSONPointer(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as RelativeJSONPointer")
+}
+
+// Regex represents the regex string format as specified by the json schema spec:
+// an ECMA-262 regular expression
+//
+// swagger:strfmt regex
+type Regex string
+
+// MarshalText turns this instance into text
+func (r Regex) MarshalText() ([]byte, error) {
+	return []byte(string(r)), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (r *Regex) UnmarshalText(data []byte) error { // validation is performed later on
+	*r = Regex(string(data))
+	return nil
+}
+
+// Scan read a value from a database driver
+func (r *Regex) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		*r = Regex(string(v))
+	case string:
+		*r = Regex(v)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Regex from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts a value to a database driver value
+func (r Regex) Value() (driver.Value, error) {
+	return driver.Value(string(r)), nil
+}
+
+func (r Regex) String() string {
+	return string(r)
+}
+
+// MarshalJSON returns the Regex as JSON
+func (r Regex) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	r.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the Regex to a easyjson.Writer
+func (r Regex) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(r))
+}
+
+// UnmarshalJSON sets the Regex from JSON
+func (r *Regex) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	r.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the Regex from a easyjson.Lexer
+func (r *Regex) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		*r = Regex(data)
+	}
+}
+
+// GetBSON returns the Regex as a bson.M{} map.
+func (r *Regex) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*r)}, nil
+}
+
+// SetBSON sets the Regex from raw bson data
+func (r *Regex) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		*r = Regex(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as Regex")
+}
@@ -0,0 +1,2041 @@
+// Code generated by strfmtgen from default_formats.json. DO NOT EDIT.
+
+package strfmt
+
+import (
+	"regexp"
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+)
+
+func TestGeneratedURI(t *testing.T) {
+	for _, str := range []string{"http://somewhere.com"} {
+		var v URI
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy URI
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy URI
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy URI
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uri", str) {
+			t.Errorf("expected %q to be a valid uri", str)
+		}
+	}
+
+	for _, str := range []string{"somewhere.com"} {
+		if Default.Validates("uri", str) {
+			t.Errorf("expected %q to be an invalid uri", str)
+		}
+	}
+
+	var v URI
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedEmail(t *testing.T) {
+	for _, str := range []string{"somebody@somewhere.com"} {
+		var v Email
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy Email
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy Email
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy Email
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("email", str) {
+			t.Errorf("expected %q to be a valid email", str)
+		}
+	}
+
+	for _, str := range []string{"somebody@somewhere@com"} {
+		if Default.Validates("email", str) {
+			t.Errorf("expected %q to be an invalid email", str)
+		}
+	}
+
+	var v Email
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedHostname(t *testing.T) {
+	for _, str := range []string{"somewhere.com"} {
+		var v Hostname
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy Hostname
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy Hostname
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy Hostname
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("hostname", str) {
+			t.Errorf("expected %q to be a valid hostname", str)
+		}
+		if !regexp.MustCompile(`^[a-zA-Z](([-0-9a-zA-Z]+)?[0-9a-zA-Z])?(\.[a-zA-Z](([-0-9a-zA-Z]+)?[0-9a-zA-Z])?)*$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of hostname", str)
+		}
+		if len(str) > 255 {
+			t.Errorf("expected %q to be at most 255 bytes long", str)
+		}
+	}
+
+	for _, str := range []string{"somewhere.com!"} {
+		if Default.Validates("hostname", str) {
+			t.Errorf("expected %q to be an invalid hostname", str)
+		}
+	}
+
+	var v Hostname
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedIPv4(t *testing.T) {
+	for _, str := range []string{"192.168.254.1"} {
+		var v IPv4
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy IPv4
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy IPv4
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy IPv4
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("ipv4", str) {
+			t.Errorf("expected %q to be a valid ipv4", str)
+		}
+		if !regexp.MustCompile(`^((25[0-5]|2[0-4][0-9]|1[0-9][0-9]|[1-9]?[0-9])\.){3}(25[0-5]|2[0-4][0-9]|1[0-9][0-9]|[1-9]?[0-9])$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of ipv4", str)
+		}
+		if len(str) > 15 {
+			t.Errorf("expected %q to be at most 15 bytes long", str)
+		}
+	}
+
+	for _, str := range []string{"192.168.254.2.2"} {
+		if Default.Validates("ipv4", str) {
+			t.Errorf("expected %q to be an invalid ipv4", str)
+		}
+	}
+
+	var v IPv4
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedIPv6(t *testing.T) {
+	for _, str := range []string{"::1"} {
+		var v IPv6
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy IPv6
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy IPv6
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy IPv6
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("ipv6", str) {
+			t.Errorf("expected %q to be a valid ipv6", str)
+		}
+		if len(str) > 45 {
+			t.Errorf("expected %q to be at most 45 bytes long", str)
+		}
+	}
+
+	for _, str := range []string{"127.0.0.1"} {
+		if Default.Validates("ipv6", str) {
+			t.Errorf("expected %q to be an invalid ipv6", str)
+		}
+	}
+
+	var v IPv6
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedMAC(t *testing.T) {
+	for _, str := range []string{"01:02:03:04:05:06"} {
+		var v MAC
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy MAC
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy MAC
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy MAC
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("mac", str) {
+			t.Errorf("expected %q to be a valid mac", str)
+		}
+	}
+
+	for _, str := range []string{"01:02:03:04:05"} {
+		if Default.Validates("mac", str) {
+			t.Errorf("expected %q to be an invalid mac", str)
+		}
+	}
+
+	var v MAC
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedUUID(t *testing.T) {
+	for _, str := range []string{"a8098c1a-f86e-11da-bd1a-00112444be1e", "2EB8AA08-AA98-11EA-B4AA-73B441D16380", "2eb8aa08-AA98-11ea-B4Aa-73B441D16380", "99c17cbb-656f-f64a-940f-1a4568f03487"} {
+		var v UUID
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy UUID
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy UUID
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy UUID
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uuid", str) {
+			t.Errorf("expected %q to be a valid uuid", str)
+		}
+		if !regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of uuid", str)
+		}
+		if len(str) > 36 {
+			t.Errorf("expected %q to be at most 36 bytes long", str)
+		}
+		if c := v.Canonical(); c.Bytes() != v.Bytes() || !Default.Validates("uuid", c.String()) {
+			t.Errorf("Canonical() = %q, expected the same valid uuid as %q", c, str)
+		}
+	}
+
+	for _, str := range []string{"not-a-uuid"} {
+		if Default.Validates("uuid", str) {
+			t.Errorf("expected %q to be an invalid uuid", str)
+		}
+	}
+
+	var v UUID
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedUUID3(t *testing.T) {
+	for _, str := range []string{"bcd02e22-68f0-3046-a512-327cca9def8f"} {
+		var v UUID3
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy UUID3
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy UUID3
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy UUID3
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uuid3", str) {
+			t.Errorf("expected %q to be a valid uuid3", str)
+		}
+		if !regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?3[0-9a-fA-F]{3}-?[0-9a-fA-F]{4}-?[0-9a-fA-F]{12}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of uuid3", str)
+		}
+		if len(str) > 36 {
+			t.Errorf("expected %q to be at most 36 bytes long", str)
+		}
+		if c := v.Canonical(); c.Bytes() != v.Bytes() || !Default.Validates("uuid3", c.String()) {
+			t.Errorf("Canonical() = %q, expected the same valid uuid3 as %q", c, str)
+		}
+	}
+
+	for _, str := range []string{"not-a-uuid"} {
+		if Default.Validates("uuid3", str) {
+			t.Errorf("expected %q to be an invalid uuid3", str)
+		}
+	}
+
+	var v UUID3
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedUUID4(t *testing.T) {
+	for _, str := range []string{"025b0d74-00a2-4048-bf57-227c5111bb34"} {
+		var v UUID4
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy UUID4
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy UUID4
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy UUID4
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uuid4", str) {
+			t.Errorf("expected %q to be a valid uuid4", str)
+		}
+		if !regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?4[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of uuid4", str)
+		}
+		if len(str) > 36 {
+			t.Errorf("expected %q to be at most 36 bytes long", str)
+		}
+		if c := v.Canonical(); c.Bytes() != v.Bytes() || !Default.Validates("uuid4", c.String()) {
+			t.Errorf("Canonical() = %q, expected the same valid uuid4 as %q", c, str)
+		}
+	}
+
+	for _, str := range []string{"not-a-uuid"} {
+		if Default.Validates("uuid4", str) {
+			t.Errorf("expected %q to be an invalid uuid4", str)
+		}
+	}
+
+	var v UUID4
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedUUID5(t *testing.T) {
+	for _, str := range []string{"886313e1-3b8a-5372-9b90-0c9aee199e5d"} {
+		var v UUID5
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy UUID5
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy UUID5
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy UUID5
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uuid5", str) {
+			t.Errorf("expected %q to be a valid uuid5", str)
+		}
+		if !regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?5[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of uuid5", str)
+		}
+		if len(str) > 36 {
+			t.Errorf("expected %q to be at most 36 bytes long", str)
+		}
+		if c := v.Canonical(); c.Bytes() != v.Bytes() || !Default.Validates("uuid5", c.String()) {
+			t.Errorf("Canonical() = %q, expected the same valid uuid5 as %q", c, str)
+		}
+	}
+
+	for _, str := range []string{"not-a-uuid"} {
+		if Default.Validates("uuid5", str) {
+			t.Errorf("expected %q to be an invalid uuid5", str)
+		}
+	}
+
+	var v UUID5
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedUUID6(t *testing.T) {
+	for _, str := range []string{"1ec9414c-232a-6b00-b3c8-9f6bdeced846"} {
+		var v UUID6
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy UUID6
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy UUID6
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy UUID6
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uuid6", str) {
+			t.Errorf("expected %q to be a valid uuid6", str)
+		}
+		if !regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?6[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of uuid6", str)
+		}
+		if len(str) > 36 {
+			t.Errorf("expected %q to be at most 36 bytes long", str)
+		}
+		if c := v.Canonical(); c.Bytes() != v.Bytes() || !Default.Validates("uuid6", c.String()) {
+			t.Errorf("Canonical() = %q, expected the same valid uuid6 as %q", c, str)
+		}
+	}
+
+	for _, str := range []string{"not-a-uuid", "1ec9414c-232a-1b00-b3c8-9f6bdeced846"} {
+		if Default.Validates("uuid6", str) {
+			t.Errorf("expected %q to be an invalid uuid6", str)
+		}
+	}
+
+	var v UUID6
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedUUID7(t *testing.T) {
+	for _, str := range []string{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f"} {
+		var v UUID7
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy UUID7
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy UUID7
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy UUID7
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uuid7", str) {
+			t.Errorf("expected %q to be a valid uuid7", str)
+		}
+		if !regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?7[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of uuid7", str)
+		}
+		if len(str) > 36 {
+			t.Errorf("expected %q to be at most 36 bytes long", str)
+		}
+		if c := v.Canonical(); c.Bytes() != v.Bytes() || !Default.Validates("uuid7", c.String()) {
+			t.Errorf("Canonical() = %q, expected the same valid uuid7 as %q", c, str)
+		}
+	}
+
+	for _, str := range []string{"not-a-uuid", "017f22e2-79b0-7cc3-c8c4-dc0c0c07398f"} {
+		if Default.Validates("uuid7", str) {
+			t.Errorf("expected %q to be an invalid uuid7", str)
+		}
+	}
+
+	var v UUID7
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedUUID8(t *testing.T) {
+	for _, str := range []string{"2489e9ad-2ee2-8e00-8ec9-32d5f69181c0"} {
+		var v UUID8
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy UUID8
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy UUID8
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy UUID8
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uuid8", str) {
+			t.Errorf("expected %q to be a valid uuid8", str)
+		}
+		if !regexp.MustCompile(`^[0-9a-fA-F]{8}-?[0-9a-fA-F]{4}-?8[0-9a-fA-F]{3}-?[89abAB][0-9a-fA-F]{3}-?[0-9a-fA-F]{12}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of uuid8", str)
+		}
+		if len(str) > 36 {
+			t.Errorf("expected %q to be at most 36 bytes long", str)
+		}
+		if c := v.Canonical(); c.Bytes() != v.Bytes() || !Default.Validates("uuid8", c.String()) {
+			t.Errorf("Canonical() = %q, expected the same valid uuid8 as %q", c, str)
+		}
+	}
+
+	for _, str := range []string{"not-a-uuid", "2489e9ad-2ee2-4e00-8ec9-32d5f69181c0"} {
+		if Default.Validates("uuid8", str) {
+			t.Errorf("expected %q to be an invalid uuid8", str)
+		}
+	}
+
+	var v UUID8
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedULID(t *testing.T) {
+	for _, str := range []string{"01ARZ3NDEKTSV4RRFFQ69G5FAV", "01arz3ndektsv4rrffq69g5fav", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"} {
+		var v ULID
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy ULID
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy ULID
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy ULID
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("ulid", str) {
+			t.Errorf("expected %q to be a valid ulid", str)
+		}
+		if !regexp.MustCompile(`^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of ulid", str)
+		}
+		if len(str) > 26 {
+			t.Errorf("expected %q to be at most 26 bytes long", str)
+		}
+	}
+
+	for _, str := range []string{"01ARZ3NDEKTSV4RRFFQ69G5FAU", "81ARZ3NDEKTSV4RRFFQ69G5FAV", "01ARZ3NDEKTSV4RRFFQ69G5FA"} {
+		if Default.Validates("ulid", str) {
+			t.Errorf("expected %q to be an invalid ulid", str)
+		}
+	}
+
+	var v ULID
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedKSUID(t *testing.T) {
+	for _, str := range []string{"0ujtsYcgvSTl8PAuAdqWYSMnLOv", "000000000000000000000000000", "aWgEPTl1tmebfsQzFP4bxwgy80V"} {
+		var v KSUID
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy KSUID
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy KSUID
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy KSUID
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("ksuid", str) {
+			t.Errorf("expected %q to be a valid ksuid", str)
+		}
+		if !regexp.MustCompile(`^[0-9A-Za-z]{27}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of ksuid", str)
+		}
+		if len(str) > 27 {
+			t.Errorf("expected %q to be at most 27 bytes long", str)
+		}
+	}
+
+	for _, str := range []string{"aWgEPTl1tmebfsQzFP4bxwgy80W", "0ujtsYcgvSTl8PAuAdqWYSMnLO", "0ujtsYcgvSTl8PAuAdqWYSMnLO-"} {
+		if Default.Validates("ksuid", str) {
+			t.Errorf("expected %q to be an invalid ksuid", str)
+		}
+	}
+
+	var v KSUID
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedISBN(t *testing.T) {
+	for _, str := range []string{"0321751043", "978-0321751041"} {
+		var v ISBN
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy ISBN
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy ISBN
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy ISBN
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("isbn", str) {
+			t.Errorf("expected %q to be a valid isbn", str)
+		}
+	}
+
+	for _, str := range []string{"836217463"} {
+		if Default.Validates("isbn", str) {
+			t.Errorf("expected %q to be an invalid isbn", str)
+		}
+	}
+
+	var v ISBN
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedISBN10(t *testing.T) {
+	for _, str := range []string{"0321751043"} {
+		var v ISBN10
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy ISBN10
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy ISBN10
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy ISBN10
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("isbn10", str) {
+			t.Errorf("expected %q to be a valid isbn10", str)
+		}
+	}
+
+	for _, str := range []string{"836217463"} {
+		if Default.Validates("isbn10", str) {
+			t.Errorf("expected %q to be an invalid isbn10", str)
+		}
+	}
+
+	var v ISBN10
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedISBN13(t *testing.T) {
+	for _, str := range []string{"978-0321751041"} {
+		var v ISBN13
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy ISBN13
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy ISBN13
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy ISBN13
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("isbn13", str) {
+			t.Errorf("expected %q to be a valid isbn13", str)
+		}
+	}
+
+	for _, str := range []string{"978-0321751042"} {
+		if Default.Validates("isbn13", str) {
+			t.Errorf("expected %q to be an invalid isbn13", str)
+		}
+	}
+
+	var v ISBN13
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedCreditCard(t *testing.T) {
+	for _, str := range []string{"4111-1111-1111-1111"} {
+		var v CreditCard
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy CreditCard
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy CreditCard
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy CreditCard
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("creditcard", str) {
+			t.Errorf("expected %q to be a valid creditcard", str)
+		}
+	}
+
+	for _, str := range []string{"9999-9999-9999-999"} {
+		if Default.Validates("creditcard", str) {
+			t.Errorf("expected %q to be an invalid creditcard", str)
+		}
+	}
+
+	var v CreditCard
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedSSN(t *testing.T) {
+	for _, str := range []string{"111-11-1111"} {
+		var v SSN
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy SSN
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy SSN
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy SSN
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("ssn", str) {
+			t.Errorf("expected %q to be a valid ssn", str)
+		}
+		if !regexp.MustCompile(`^[0-9]{3}[- ][0-9]{2}[- ][0-9]{4}$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of ssn", str)
+		}
+		if len(str) > 11 {
+			t.Errorf("expected %q to be at most 11 bytes long", str)
+		}
+	}
+
+	for _, str := range []string{"999 99 999"} {
+		if Default.Validates("ssn", str) {
+			t.Errorf("expected %q to be an invalid ssn", str)
+		}
+	}
+
+	var v SSN
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedHexColor(t *testing.T) {
+	for _, str := range []string{"#FFFFFF"} {
+		var v HexColor
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy HexColor
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy HexColor
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy HexColor
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("hexcolor", str) {
+			t.Errorf("expected %q to be a valid hexcolor", str)
+		}
+		if !regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of hexcolor", str)
+		}
+		if len(str) > 7 {
+			t.Errorf("expected %q to be at most 7 bytes long", str)
+		}
+	}
+
+	for _, str := range []string{"#fffffffz"} {
+		if Default.Validates("hexcolor", str) {
+			t.Errorf("expected %q to be an invalid hexcolor", str)
+		}
+	}
+
+	var v HexColor
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedRGBColor(t *testing.T) {
+	for _, str := range []string{"rgb(255,255,255)"} {
+		var v RGBColor
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy RGBColor
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy RGBColor
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy RGBColor
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("rgbcolor", str) {
+			t.Errorf("expected %q to be a valid rgbcolor", str)
+		}
+		if !regexp.MustCompile(`^rgb\(\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*,\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*,\s*(0|[1-9]\d?|1\d\d?|2[0-4]\d|25[0-5])\s*\)$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of rgbcolor", str)
+		}
+	}
+
+	for _, str := range []string{"rgb(300,0,0)"} {
+		if Default.Validates("rgbcolor", str) {
+			t.Errorf("expected %q to be an invalid rgbcolor", str)
+		}
+	}
+
+	var v RGBColor
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedPassword(t *testing.T) {
+	for _, str := range []string{"super secret stuff here"} {
+		var v Password
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy Password
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy Password
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy Password
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("password", str) {
+			t.Errorf("expected %q to be a valid password", str)
+		}
+	}
+
+	for _, str := range []string{} {
+		if Default.Validates("password", str) {
+			t.Errorf("expected %q to be an invalid password", str)
+		}
+	}
+
+	var v Password
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedURIReference(t *testing.T) {
+	for _, str := range []string{"http://foo.bar/?baz=qux#quux", "//foo.bar/?baz=qux#quux", "/abc", "#fragment"} {
+		var v URIReference
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy URIReference
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy URIReference
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy URIReference
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uri-reference", str) {
+			t.Errorf("expected %q to be a valid uri-reference", str)
+		}
+	}
+
+	for _, str := range []string{"\\\\WINDOWS\\fileshare", "#frag\\ment"} {
+		if Default.Validates("uri-reference", str) {
+			t.Errorf("expected %q to be an invalid uri-reference", str)
+		}
+	}
+
+	var v URIReference
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedIRI(t *testing.T) {
+	for _, str := range []string{"http://ƒøø.ßår/?∂éœ=πîx#πîüx", "http://[2001:0db8:85a3:0000:0000:8a2e:0370:7334]"} {
+		var v IRI
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy IRI
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy IRI
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy IRI
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("iri", str) {
+			t.Errorf("expected %q to be a valid iri", str)
+		}
+	}
+
+	for _, str := range []string{"/abc", "http://2001:0db8:85a3:0000:0000:8a2e:0370:7334"} {
+		if Default.Validates("iri", str) {
+			t.Errorf("expected %q to be an invalid iri", str)
+		}
+	}
+
+	var v IRI
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedIRIReference(t *testing.T) {
+	for _, str := range []string{"http://ƒøø.ßår/?∂éœ=πîx#πîüx", "/âππ", "#ƒrägmênt"} {
+		var v IRIReference
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy IRIReference
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy IRIReference
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy IRIReference
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("iri-reference", str) {
+			t.Errorf("expected %q to be a valid iri-reference", str)
+		}
+	}
+
+	for _, str := range []string{"\\\\WINDOWS\\filëßåré", "#ƒräg\\mênt"} {
+		if Default.Validates("iri-reference", str) {
+			t.Errorf("expected %q to be an invalid iri-reference", str)
+		}
+	}
+
+	var v IRIReference
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedIDNEmail(t *testing.T) {
+	for _, str := range []string{"실례@실례.테스트", "joe.bloggs@example.com"} {
+		var v IDNEmail
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy IDNEmail
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy IDNEmail
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy IDNEmail
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("idn-email", str) {
+			t.Errorf("expected %q to be a valid idn-email", str)
+		}
+	}
+
+	for _, str := range []string{"2962", "joe..bloggs@example.com"} {
+		if Default.Validates("idn-email", str) {
+			t.Errorf("expected %q to be an invalid idn-email", str)
+		}
+	}
+
+	var v IDNEmail
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedIDNHostname(t *testing.T) {
+	for _, str := range []string{"실례.테스트", "xn--ihqwcrb4cv8a8dqg056pqjye"} {
+		var v IDNHostname
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy IDNHostname
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy IDNHostname
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy IDNHostname
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("idn-hostname", str) {
+			t.Errorf("expected %q to be a valid idn-hostname", str)
+		}
+	}
+
+	for _, str := range []string{"〮실례.테스트", "-hello"} {
+		if Default.Validates("idn-hostname", str) {
+			t.Errorf("expected %q to be an invalid idn-hostname", str)
+		}
+	}
+
+	var v IDNHostname
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedURITemplate(t *testing.T) {
+	for _, str := range []string{"http://example.com/dictionary/{term:1}/{term}", "http://example.com/search{?q,lang}"} {
+		var v URITemplate
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy URITemplate
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy URITemplate
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy URITemplate
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("uri-template", str) {
+			t.Errorf("expected %q to be a valid uri-template", str)
+		}
+	}
+
+	for _, str := range []string{"http://example.com/dictionary/{term:1}/{term"} {
+		if Default.Validates("uri-template", str) {
+			t.Errorf("expected %q to be an invalid uri-template", str)
+		}
+	}
+
+	var v URITemplate
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedJSONPointer(t *testing.T) {
+	for _, str := range []string{"/foo/bar~0/baz~1/%a", ""} {
+		var v JSONPointer
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy JSONPointer
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy JSONPointer
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy JSONPointer
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("json-pointer", str) {
+			t.Errorf("expected %q to be a valid json-pointer", str)
+		}
+		if !regexp.MustCompile(`^(/([^~]|~[01])*)*$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of json-pointer", str)
+		}
+	}
+
+	for _, str := range []string{"/foo/bar~", "#/foo"} {
+		if Default.Validates("json-pointer", str) {
+			t.Errorf("expected %q to be an invalid json-pointer", str)
+		}
+	}
+
+	var v JSONPointer
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedRelativeJSONPointer(t *testing.T) {
+	for _, str := range []string{"1", "0/foo/bar", "0#"} {
+		var v RelativeJSONPointer
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy RelativeJSONPointer
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy RelativeJSONPointer
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy RelativeJSONPointer
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("relative-json-pointer", str) {
+			t.Errorf("expected %q to be a valid relative-json-pointer", str)
+		}
+		if !regexp.MustCompile(`^(0|[1-9][0-9]*)(#|(/([^~]|~[01])*)*)$`).MatchString(str) {
+			t.Errorf("expected %q to match the pattern of relative-json-pointer", str)
+		}
+	}
+
+	for _, str := range []string{"/foo/bar", "01#"} {
+		if Default.Validates("relative-json-pointer", str) {
+			t.Errorf("expected %q to be an invalid relative-json-pointer", str)
+		}
+	}
+
+	var v RelativeJSONPointer
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
+
+func TestGeneratedRegex(t *testing.T) {
+	for _, str := range []string{"([abc])+\\s+$", "^(?<year>[0-9]{4})-\\k<year>$"} {
+		var v Regex
+		if err := v.UnmarshalText([]byte(str)); err != nil {
+			t.Fatalf("UnmarshalText(%q) failed: %v", str, err)
+		}
+		if b, err := v.MarshalText(); err != nil || string(b) != str {
+			t.Errorf("MarshalText() = %q, %v, expected %q", b, err, str)
+		}
+		if v.String() != str {
+			t.Errorf("String() = %q, expected %q", v.String(), str)
+		}
+
+		var jsonCopy Regex
+		bj, err := v.MarshalJSON()
+		if err != nil {
+			t.Errorf("MarshalJSON() failed for %q: %v", str, err)
+		}
+		if err := jsonCopy.UnmarshalJSON(bj); err != nil || jsonCopy != v {
+			t.Errorf("UnmarshalJSON(%s) = %q, %v, expected %q", bj, jsonCopy, err, str)
+		}
+
+		var sqlCopy Regex
+		dv, err := v.Value()
+		if err != nil {
+			t.Errorf("Value() failed for %q: %v", str, err)
+		}
+		if err := sqlCopy.Scan(dv); err != nil || sqlCopy != v {
+			t.Errorf("Scan(%#v) = %q, %v, expected %q", dv, sqlCopy, err, str)
+		}
+		if err := sqlCopy.Scan([]byte(str)); err != nil || sqlCopy != v {
+			t.Errorf("Scan([]byte(%q)) = %q, %v, expected %q", str, sqlCopy, err, str)
+		}
+
+		var bsonCopy Regex
+		bsonData, err := bson.Marshal(&v)
+		if err != nil {
+			t.Errorf("bson.Marshal() failed for %q: %v", str, err)
+		}
+		if err := bson.Unmarshal(bsonData, &bsonCopy); err != nil || bsonCopy != v {
+			t.Errorf("bson.Unmarshal() = %q, %v, expected %q", bsonCopy, err, str)
+		}
+
+		if !Default.Validates("regex", str) {
+			t.Errorf("expected %q to be a valid regex", str)
+		}
+	}
+
+	for _, str := range []string{"^(abc]", "a{2,1}"} {
+		if Default.Validates("regex", str) {
+			t.Errorf("expected %q to be an invalid regex", str)
+		}
+	}
+
+	var v Regex
+	if err := v.Scan(42); err == nil {
+		t.Errorf("expected Scan(42) to fail")
+	}
+}
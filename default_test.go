@@ -686,6 +686,18 @@ func TestFormatBase64(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, b64, b64Copy)
 
+	// the text is written in the URL alphabet, and read in the URL or the standard alphabet
+	var bt Base64
+	assert.NoError(t, bt.UnmarshalText([]byte("-_8=")))
+	assert.Equal(t, Base64{0xfb, 0xff}, bt)
+	b, err = bt.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("-_8="), b)
+	bt = nil
+	assert.NoError(t, bt.UnmarshalText([]byte("+/8=")))
+	assert.Equal(t, Base64{0xfb, 0xff}, bt)
+	assert.Error(t, bt.UnmarshalText([]byte("+_8=")))
+
 	testValid(t, "byte", str)
 	testInvalid(t, "byte", "ZWxpemFiZXRocG9zZXk") // missing pad char
 }
@@ -18,10 +18,12 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
-	"regexp"
+	"math"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"gopkg.in/mgo.v2/bson"
 
@@ -32,19 +34,25 @@ import (
 func init() {
 	d := Duration(0)
 	// register this format in the default registry
-	Default.Add("duration", &d, IsDuration)
+	AddWithInfo(Default, "duration", &d, ValidateDuration, FormatInfo{
+		Description:     "A duration, either in Go syntax, as an ISO 8601 duration or as a number followed by a unit from ns to weeks",
+		Examples:        []string{"1h30m", "P4DT12H30M5S", "-PT1.5S", "3 weeks", "45 days"},
+		InvalidExamples: []string{"yada", "12 parsecs", "P2D1Y", "PT1D"},
+	})
 }
 
 var (
+	// timeUnits are the spellings of the units of durations, with the
+	// abbreviation first and the singular and plural long forms last
 	timeUnits = [][]string{
-		{"ns", "nano"},
-		{"us", "µs", "micro"},
-		{"ms", "milli"},
-		{"s", "sec"},
-		{"m", "min"},
-		{"h", "hr", "hour"},
-		{"d", "day"},
-		{"w", "wk", "week"},
+		{"ns", "nano", "nanos", "nanosec", "nanosecs", "nanosecond", "nanoseconds"},
+		{"us", "µs", "μs", "micro", "micros", "microsec", "microsecs", "microsecond", "microseconds"},
+		{"ms", "milli", "millis", "millisec", "millisecs", "millisecond", "milliseconds"},
+		{"s", "sec", "secs", "second", "seconds"},
+		{"m", "min", "mins", "minute", "minutes"},
+		{"h", "hr", "hrs", "hour", "hours"},
+		{"d", "day", "days"},
+		{"w", "wk", "wks", "week", "weeks"},
 	}
 
 	timeMultiplier = map[string]time.Duration{
@@ -58,15 +66,30 @@ var (
 		"w":  7 * 24 * time.Hour,
 	}
 
-	durationMatcher = regexp.MustCompile(`((\d+)\s*([A-Za-zµ]+))`)
+	// unitMultiplier is the multiplier of each spelling of a unit
+	unitMultiplier = make(map[string]time.Duration)
 )
 
+func init() {
+	for _, variants := range timeUnits {
+		for _, variant := range variants {
+			unitMultiplier[variant] = timeMultiplier[variants[0]]
+		}
+	}
+}
+
 // IsDuration returns true if the provided string is a valid duration
 func IsDuration(str string) bool {
 	_, err := ParseDuration(str)
 	return err == nil
 }
 
+// ValidateDuration returns a *FormatError when the string is not a valid duration
+func ValidateDuration(str string) error {
+	_, err := ParseDuration(str)
+	return err
+}
+
 // Duration represents a duration
 //
 // Duration stores a period of time as a nanosecond count, with the largest
@@ -90,39 +113,155 @@ func (d *Duration) UnmarshalText(data []byte) error { // validation is performed
 	return nil
 }
 
-// ParseDuration parses a duration from a string, compatible with scala duration syntax
+// ParseDuration parses a duration from a string, compatible with scala duration syntax.
+//
+// On top of Go durations (e.g. "1h30m"), it accepts a sequence of numbers followed
+// by units from nanoseconds to weeks, optionally separated by spaces or commas,
+// e.g. "3 weeks", "1.5 hours" or "-2 days, 4h". A leading sign applies to the
+// whole duration. The units are listed in timeUnits. Surrounding spaces are ignored.
+//
+// Strings starting with "P", "-P" or "+P" are parsed as ISO 8601 durations,
+// e.g. "PT1H30M" or "P2DT3H", where years and months last 365 and 30 days.
+//
+// When the string is not a valid duration, the error is a *FormatError telling
+// the offset of the first invalid token.
 func ParseDuration(cand string) (time.Duration, error) {
 	if dur, err := time.ParseDuration(cand); err == nil {
 		return dur, nil
 	}
+	if str := strings.TrimSpace(cand); str != cand {
+		dur, err := ParseDuration(str)
+		if err != nil {
+			// offsets are reported in the string with its spaces
+			return 0, shiftFormatError(err, "duration", cand, strings.Index(cand, str))
+		}
+		return dur, nil
+	}
+	if isISODuration(cand) {
+		return parseISODuration(cand)
+	}
+	return parseHumanDuration(cand)
+}
+
+// parseHumanDuration parses a sequence of numbers followed by units:
+//
+//	duration = [ "-" / "+" ] component *( [ separator ] component )
+//	component = number *WSP unit
+//	number = 1*DIGIT [ "." *DIGIT ] / "." 1*DIGIT
+//	separator = *WSP [ "," ] *WSP
+func parseHumanDuration(str string) (time.Duration, error) {
+	const name = "duration"
+	i := 0
+	negative := false
+	if i < len(str) && (str[i] == '-' || str[i] == '+') {
+		negative = str[i] == '-'
+		i++
+	}
 
 	var dur time.Duration
-	ok := false
-	for _, match := range durationMatcher.FindAllStringSubmatch(cand, -1) {
+	for {
+		// number
+		start := i
+		for i < len(str) && isDigit(str[i]) {
+			i++
+		}
+		integer := str[start:i]
+		fraction := ""
+		if i < len(str) && str[i] == '.' {
+			i++
+			digits := i
+			for i < len(str) && isDigit(str[i]) {
+				i++
+			}
+			fraction = str[digits:i]
+		}
+		if integer == "" && fraction == "" {
+			if i == len(str) {
+				return 0, newFormatError(name, str, i, ReasonInvalidSyntax)
+			}
+			return 0, newFormatError(name, str, start, ReasonInvalidCharacter)
+		}
 
-		factor, err := strconv.Atoi(match[2]) // converts string to int
-		if err != nil {
-			return 0, err
+		// unit
+		for i < len(str) && isSpace(str[i]) {
+			i++
+		}
+		unitStart := i
+		for i < len(str) {
+			r, size := utf8.DecodeRuneInString(str[i:])
+			if !unicode.IsLetter(r) {
+				break
+			}
+			i += size
+		}
+		if i == unitStart {
+			return 0, newFormatError(name, str, i, ReasonInvalidSyntax)
+		}
+		multiplier, ok := unitMultiplier[strings.ToLower(str[unitStart:i])]
+		if !ok {
+			return 0, newFormatError(name, str, unitStart, ReasonInvalidSyntax)
 		}
-		unit := strings.ToLower(strings.TrimSpace(match[3]))
 
-		for _, variants := range timeUnits {
-			last := len(variants) - 1
-			multiplier := timeMultiplier[variants[0]]
+		value, ok := durationValue(integer, fraction, multiplier)
+		if !ok || dur > math.MaxInt64-value {
+			return 0, newFormatError(name, str, start, ReasonOutOfRange)
+		}
+		dur += value
 
-			for i, variant := range variants {
-				if (last == i && strings.HasPrefix(unit, variant)) || strings.EqualFold(variant, unit) {
-					ok = true
-					dur += (time.Duration(factor) * multiplier)
-				}
+		// separator
+		for i < len(str) && isSpace(str[i]) {
+			i++
+		}
+		if i < len(str) && str[i] == ',' {
+			i++
+			for i < len(str) && isSpace(str[i]) {
+				i++
+			}
+			if i == len(str) {
+				return 0, newFormatError(name, str, i, ReasonInvalidSyntax)
 			}
 		}
+		if i == len(str) {
+			break
+		}
 	}
 
-	if ok {
-		return dur, nil
+	if negative {
+		dur = -dur
+	}
+	return dur, nil
+}
+
+// durationValue returns the value of the number integer.fraction of a unit,
+// it returns false on overflow
+func durationValue(integer, fraction string, multiplier time.Duration) (time.Duration, bool) {
+	var n int64
+	if integer != "" {
+		var err error
+		if n, err = strconv.ParseInt(integer, 10, 64); err != nil || n > math.MaxInt64/int64(multiplier) {
+			return 0, false
+		}
 	}
-	return 0, fmt.Errorf("Unable to parse %s as duration", cand)
+	value := time.Duration(n) * multiplier
+	if fraction != "" {
+		if len(fraction) > 18 {
+			fraction = fraction[:18]
+		}
+		f, _ := strconv.ParseInt(fraction, 10, 64)
+		scale := math.Pow10(len(fraction))
+		// rounds to the closest nanosecond
+		frac := time.Duration(float64(f)*(float64(multiplier)/scale) + 0.5)
+		if value > math.MaxInt64-frac {
+			return 0, false
+		}
+		value += frac
+	}
+	return value, true
+}
+
+// isSpace reports whether c is a space or a tab
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t'
 }
 
 // Scan reads a Duration value from database driver type.
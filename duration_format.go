@@ -0,0 +1,277 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+// DurationFormat describes how a duration is written with the units of human
+// readable durations, from nanoseconds to weeks, e.g. "1w" or "2 days 3 hours".
+//
+// The zero value writes abbreviated units from weeks to nanoseconds, e.g. "1w2d3h".
+type DurationFormat struct {
+	// Largest is the largest unit used, weeks when zero
+	Largest time.Duration
+	// Smallest is the smallest unit used, nanoseconds when zero
+	Smallest time.Duration
+	// Round rounds the duration to the smallest unit, half away from zero,
+	// instead of truncating it
+	Round bool
+	// Long writes the units in full, separated by spaces, e.g. "1 week 2 days"
+	Long bool
+}
+
+// durationUnit is a unit of a formatted duration
+type durationUnit struct {
+	multiplier time.Duration
+	short      string
+	singular   string
+	plural     string
+}
+
+// durationUnits are the units of formatted durations, from the largest to the smallest
+var durationUnits []durationUnit
+
+func init() {
+	for i := len(timeUnits) - 1; i >= 0; i-- {
+		variants := timeUnits[i]
+		durationUnits = append(durationUnits, durationUnit{
+			multiplier: timeMultiplier[variants[0]],
+			short:      variants[0],
+			singular:   variants[len(variants)-2],
+			plural:     variants[len(variants)-1],
+		})
+	}
+}
+
+// units returns the units of the format, from the largest to the smallest
+func (f DurationFormat) units() []durationUnit {
+	largest, smallest := f.Largest, f.Smallest
+	if largest <= 0 {
+		largest = durationUnits[0].multiplier
+	}
+	if smallest <= 0 {
+		smallest = time.Nanosecond
+	}
+	if largest < smallest {
+		largest = smallest
+	}
+
+	first, last := -1, -1
+	for i, u := range durationUnits {
+		if u.multiplier > largest || u.multiplier < smallest {
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		last = i
+	}
+	if first < 0 {
+		// no unit in range: use the largest unit below it
+		first = len(durationUnits) - 1
+		for i, u := range durationUnits {
+			if u.multiplier <= largest {
+				first = i
+				break
+			}
+		}
+		last = first
+	}
+	return durationUnits[first : last+1]
+}
+
+// Format formats a duration, with a leading "-" for negative durations.
+//
+// A zero duration, or one which is truncated to zero, is written with the
+// largest unit up to seconds, e.g. "0s" or "0 seconds".
+func (f DurationFormat) Format(d time.Duration) string {
+	units := f.units()
+	smallest := uint64(units[len(units)-1].multiplier)
+
+	// the absolute value of the smallest duration overflows a time.Duration, not an uint64
+	u := uint64(d)
+	if d < 0 {
+		u = uint64(-d)
+	}
+	if f.Round {
+		u += smallest / 2
+	}
+	u -= u % smallest
+
+	var buf []byte
+	if d < 0 && u > 0 {
+		buf = append(buf, '-')
+	}
+	if u == 0 {
+		zero := units[len(units)-1]
+		for _, unit := range units {
+			if unit.multiplier <= time.Second {
+				zero = unit
+				break
+			}
+		}
+		return string(f.appendUnit(buf, 0, zero))
+	}
+
+	for _, unit := range units {
+		n := u / uint64(unit.multiplier)
+		if n == 0 {
+			continue
+		}
+		u -= n * uint64(unit.multiplier)
+		if f.Long && len(buf) > 0 && buf[len(buf)-1] != '-' {
+			buf = append(buf, ' ')
+		}
+		buf = f.appendUnit(buf, n, unit)
+	}
+	return string(buf)
+}
+
+// appendUnit appends n units to buf
+func (f DurationFormat) appendUnit(buf []byte, n uint64, unit durationUnit) []byte {
+	buf = strconv.AppendUint(buf, n, 10)
+	switch {
+	case !f.Long:
+		return append(buf, unit.short...)
+	case n == 1:
+		return append(append(buf, ' '), unit.singular...)
+	default:
+		return append(append(buf, ' '), unit.plural...)
+	}
+}
+
+// humanDurationFormat is the format of HumanDuration, which can't be changed at run time
+var humanDurationFormat = DurationFormat{Long: true}
+
+// HumanDuration is a Duration which serializes with the units of human readable
+// durations, e.g. "1 week" or "2 days 3 hours", instead of the Go syntax.
+//
+// It parses the same durations as Duration.
+//
+// swagger:strfmt duration
+type HumanDuration time.Duration
+
+// String converts this duration to a human readable duration
+func (d HumanDuration) String() string {
+	return humanDurationFormat.Format(time.Duration(d))
+}
+
+// MarshalText turns this instance into text
+func (d HumanDuration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (d *HumanDuration) UnmarshalText(data []byte) error {
+	dd, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = HumanDuration(dd)
+	return nil
+}
+
+// Scan reads a HumanDuration value from database driver type.
+//
+// Integers are read as nanoseconds, like for Duration.
+func (d *HumanDuration) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return d.UnmarshalText(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case int64:
+		*d = HumanDuration(v)
+	case float64:
+		*d = HumanDuration(int64(v))
+	case nil:
+		*d = HumanDuration(0)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.HumanDuration from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts HumanDuration to a primitive value ready to be written to a database.
+func (d HumanDuration) Value() (driver.Value, error) {
+	return driver.Value(d.String()), nil
+}
+
+// MarshalJSON returns the HumanDuration as JSON
+func (d HumanDuration) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	d.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the HumanDuration to a easyjson.Writer
+func (d HumanDuration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(d.String())
+}
+
+// UnmarshalJSON sets the HumanDuration from JSON
+func (d *HumanDuration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	d.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the HumanDuration from a easyjson.Lexer
+func (d *HumanDuration) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		dd, err := ParseDuration(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*d = HumanDuration(dd)
+	}
+}
+
+// GetBSON returns the HumanDuration as a bson.M{} map.
+func (d *HumanDuration) GetBSON() (interface{}, error) {
+	return bson.M{"data": d.String()}, nil
+}
+
+// SetBSON sets the HumanDuration from raw bson data, either a duration
+// or a number of nanoseconds stored by a Duration
+func (d *HumanDuration) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	switch data := m["data"].(type) {
+	case string:
+		return d.UnmarshalText([]byte(data))
+	case int64:
+		*d = HumanDuration(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as HumanDuration")
+}
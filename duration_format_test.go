@@ -0,0 +1,139 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDurationFormat(t *testing.T) {
+	const (
+		day  = 24 * time.Hour
+		week = 7 * day
+	)
+	long := DurationFormat{Long: true}
+
+	testCases := []struct {
+		format DurationFormat
+		in     time.Duration
+		str    string
+	}{
+		{DurationFormat{}, week, "1w"},
+		{DurationFormat{}, 0, "0s"},
+		{DurationFormat{}, 2*day + 3*time.Hour, "2d3h"},
+		{DurationFormat{}, 90*time.Minute + 1500*time.Microsecond, "1h30m1ms500us"},
+		{DurationFormat{}, -36 * time.Hour, "-1d12h"},
+		{long, week, "1 week"},
+		{long, 2*day + 3*time.Hour, "2 days 3 hours"},
+		{long, -(time.Minute + time.Nanosecond), "-1 minute 1 nanosecond"},
+		{long, 0, "0 seconds"},
+		{DurationFormat{Largest: day}, 2 * week, "14d"},
+		{DurationFormat{Largest: time.Hour, Long: true}, 2 * day, "48 hours"},
+		{DurationFormat{Smallest: time.Second}, 90*time.Second + 999*time.Millisecond, "1m30s"},
+		{DurationFormat{Smallest: time.Second, Round: true}, 90*time.Second + 500*time.Millisecond, "1m31s"},
+		{DurationFormat{Smallest: time.Second, Round: true}, -(90*time.Second + 500*time.Millisecond), "-1m31s"},
+		{DurationFormat{Smallest: time.Second, Round: true}, 90*time.Second + 499*time.Millisecond, "1m30s"},
+		{DurationFormat{Smallest: time.Hour}, 59 * time.Minute, "0h"},
+		{DurationFormat{Smallest: time.Hour}, -59 * time.Minute, "0h"},
+		{DurationFormat{Smallest: day, Round: true}, 12 * time.Hour, "1d"},
+		{DurationFormat{Largest: time.Second, Smallest: time.Millisecond}, 0, "0s"},
+		{DurationFormat{Largest: time.Millisecond}, 0, "0ms"},
+		{DurationFormat{Largest: time.Millisecond, Smallest: time.Microsecond}, time.Second, "1000ms"},
+		// no unit between 90 and 100 minutes: hours are used
+		{DurationFormat{Largest: 100 * time.Minute, Smallest: 90 * time.Minute}, 150 * time.Minute, "2h"},
+		{DurationFormat{Largest: day}, math.MinInt64, "-106751d23h47m16s854ms775us808ns"},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.str, tc.format.Format(tc.in), "%#v", tc.format)
+	}
+
+	// formatted durations are parsed back
+	for _, format := range []DurationFormat{{}, long} {
+		for _, d := range []time.Duration{week + time.Nanosecond, -3 * day, time.Minute + 5*time.Millisecond, math.MaxInt64, math.MinInt64 + 1} {
+			back, err := ParseDuration(format.Format(d))
+			assert.NoError(t, err)
+			assert.Equal(t, d, back)
+		}
+	}
+}
+
+func TestHumanDuration(t *testing.T) {
+	d := HumanDuration(2*24*time.Hour + 3*time.Hour)
+	const str = "2 days 3 hours"
+	assert.Equal(t, str, d.String())
+
+	txt, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, str, string(txt))
+
+	js, err := d.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+str+`"`, string(js))
+
+	val, err := d.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, str, val)
+
+	var back HumanDuration
+	assert.NoError(t, back.UnmarshalText([]byte("51h")))
+	assert.Equal(t, d, back)
+	back = 0
+	assert.NoError(t, back.UnmarshalJSON(js))
+	assert.Equal(t, d, back)
+	assert.Error(t, back.UnmarshalJSON([]byte(`"yada"`)))
+	assert.Equal(t, d, back)
+
+	back = 0
+	assert.NoError(t, back.Scan("P2DT3H"))
+	assert.Equal(t, d, back)
+	assert.NoError(t, back.Scan(int64(d)))
+	assert.Equal(t, d, back)
+	assert.NoError(t, back.Scan(float64(d)))
+	assert.Equal(t, d, back)
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, HumanDuration(0), back)
+	assert.Error(t, back.Scan(true))
+
+	bsonData, err := bson.Marshal(&d)
+	assert.NoError(t, err)
+	back = 0
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, d, back)
+
+	// durations stored as nanoseconds are read back
+	nanos := Duration(d)
+	bsonData, err = bson.Marshal(&nanos)
+	assert.NoError(t, err)
+	back = 0
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, d, back)
+}
+
+func TestHumanDuration_registry(t *testing.T) {
+	// a registry may parse durations as human readable durations
+	registry := NewFormats().(*defaultFormats)
+	registry.AddE("duration", new(HumanDuration), ValidateDuration)
+
+	v, err := registry.Parse("duration", "168h")
+	assert.NoError(t, err)
+	assert.IsType(t, new(HumanDuration), v)
+	assert.Equal(t, "1 week", v.(*HumanDuration).String())
+}
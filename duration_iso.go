@@ -0,0 +1,363 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+const (
+	// isoDay is the nominal length of a day in an ISO 8601 duration
+	isoDay = 24 * time.Hour
+	// isoMonth is the nominal length of a month in an ISO 8601 duration
+	isoMonth = 30 * isoDay
+	// isoYear is the nominal length of a year in an ISO 8601 duration
+	isoYear = 365 * isoDay
+)
+
+// isoDesignator is an element of an ISO 8601 duration
+type isoDesignator struct {
+	designator byte
+	unit       time.Duration
+}
+
+var (
+	isoDateDesignators = []isoDesignator{{'Y', isoYear}, {'M', isoMonth}, {'W', 7 * isoDay}, {'D', isoDay}}
+	isoTimeDesignators = []isoDesignator{{'H', time.Hour}, {'M', time.Minute}, {'S', time.Second}}
+)
+
+// isISODuration reports whether str is meant to be an ISO 8601 duration
+func isISODuration(str string) bool {
+	if str != "" && (str[0] == '-' || str[0] == '+') {
+		str = str[1:]
+	}
+	return str != "" && str[0] == 'P'
+}
+
+// isoElement is an element of an ISO 8601 duration, e.g. "1.5H"
+type isoElement struct {
+	designator byte
+	inTime     bool
+	// unit is the nominal length of the element
+	unit  time.Duration
+	value int64
+	// nanos is the fraction of the value, in billionths
+	nanos int64
+	// offset is the offset of the number of the element
+	offset int
+}
+
+// parseISOElements splits an ISO 8601 duration into its elements:
+//
+//	[ "-" / "+" ] "P" ( 1*DIGIT "W" / [ 1*DIGIT "Y" ] [ 1*DIGIT "M" ] [ 1*DIGIT "D" ]
+//	  [ "T" [ 1*DIGIT "H" ] [ 1*DIGIT "M" ] [ 1*DIGIT "S" ] ] )
+//
+// with at least one element, where the number of the last element may have a
+// fraction, separated by a dot or a comma. Weeks can't be combined with other
+// elements. When signed is true, the numbers may be negative.
+//
+// It returns the offset of the first invalid character, or -1 when str is valid.
+func parseISOElements(str string, signed bool) (negative bool, elements []isoElement, bad int) {
+	i := 0
+	if str[0] == '-' || str[0] == '+' {
+		negative = str[0] == '-'
+		i++
+	}
+	i++ // P
+
+	designators := isoDateDesignators
+	timeElements := 0
+	inTime, weeks, fractional := false, false, false
+	for i < len(str) {
+		if str[i] == 'T' {
+			if inTime || fractional {
+				return false, nil, i
+			}
+			inTime = true
+			designators = isoTimeDesignators
+			i++
+			continue
+		}
+		if fractional {
+			// only the last element may have a fraction
+			return false, nil, i
+		}
+
+		start := i
+		if signed && str[i] == '-' {
+			i++
+		}
+		digits := i
+		for i < len(str) && isDigit(str[i]) {
+			i++
+		}
+		if i == digits {
+			return false, nil, i
+		}
+		n, err := strconv.ParseInt(str[start:i], 10, 64)
+		if err != nil {
+			return false, nil, start
+		}
+		var nanos int64
+		if i < len(str) && (str[i] == '.' || str[i] == ',') {
+			i++
+			digits := i
+			for i < len(str) && isDigit(str[i]) {
+				i++
+			}
+			if i == digits {
+				return false, nil, i
+			}
+			fraction := str[digits:i]
+			if len(fraction) > 9 {
+				fraction = fraction[:9]
+			}
+			nanos, _ = strconv.ParseInt(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+			if str[start] == '-' {
+				nanos = -nanos
+			}
+			fractional = true
+		}
+		if i == len(str) {
+			return false, nil, i
+		}
+
+		// the designators must come in order
+		j := 0
+		for j < len(designators) && designators[j].designator != str[i] {
+			j++
+		}
+		if j == len(designators) {
+			return false, nil, i
+		}
+		weeks = weeks || str[i] == 'W' && !inTime
+		if weeks && len(elements) > 0 {
+			return false, nil, i
+		}
+		elements = append(elements, isoElement{designator: str[i], inTime: inTime, unit: designators[j].unit, value: n, nanos: nanos, offset: start})
+		designators = designators[j+1:]
+		if inTime {
+			timeElements++
+		}
+		i++
+	}
+
+	if len(elements) == 0 || inTime && timeElements == 0 {
+		return false, nil, len(str)
+	}
+	if weeks && len(elements) > 1 {
+		return false, nil, len(str)
+	}
+	return negative, elements, -1
+}
+
+// parseISODuration parses an ISO 8601 duration, where years and months have a
+// nominal length of 365 and 30 days
+func parseISODuration(str string) (time.Duration, error) {
+	const name = "duration"
+	negative, elements, bad := parseISOElements(str, false)
+	if bad >= 0 {
+		return 0, newFormatError(name, str, bad, ReasonInvalidSyntax)
+	}
+
+	var dur time.Duration
+	for _, e := range elements {
+		if e.value > math.MaxInt64/int64(e.unit) {
+			return 0, newFormatError(name, str, e.offset, ReasonOutOfRange)
+		}
+		// units are whole numbers of seconds, so the fraction fits in nanoseconds
+		value := time.Duration(e.value)*e.unit + time.Duration(e.nanos*int64(e.unit/time.Second))
+		if value < 0 || dur > math.MaxInt64-value {
+			return 0, newFormatError(name, str, e.offset, ReasonOutOfRange)
+		}
+		dur += value
+	}
+	if negative {
+		dur = -dur
+	}
+	return dur, nil
+}
+
+// FormatISODuration formats a duration as an ISO 8601 duration, in hours,
+// minutes and seconds, e.g. "PT36H" or "-PT1M30.5S".
+//
+// Days are not used since they may not last 24 hours in a time zone.
+func FormatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var buf []byte
+	// the absolute value of the smallest duration overflows a time.Duration, not an uint64
+	u := uint64(d)
+	if d < 0 {
+		buf = append(buf, '-')
+		u = uint64(-d)
+	}
+	buf = append(buf, "PT"...)
+
+	return string(appendISOTime(buf, u, ""))
+}
+
+// appendISOTime appends the hours, minutes and seconds of the time element of
+// an ISO 8601 duration of u nanoseconds, each number prefixed with sign
+func appendISOTime(buf []byte, u uint64, sign string) []byte {
+	hours := u / uint64(time.Hour)
+	u -= hours * uint64(time.Hour)
+	minutes := u / uint64(time.Minute)
+	u -= minutes * uint64(time.Minute)
+	seconds, nanos := u/uint64(time.Second), u%uint64(time.Second)
+
+	if hours > 0 {
+		buf = append(buf, sign...)
+		buf = strconv.AppendUint(buf, hours, 10)
+		buf = append(buf, 'H')
+	}
+	if minutes > 0 {
+		buf = append(buf, sign...)
+		buf = strconv.AppendUint(buf, minutes, 10)
+		buf = append(buf, 'M')
+	}
+	if seconds > 0 || nanos > 0 {
+		buf = append(buf, sign...)
+		buf = strconv.AppendUint(buf, seconds, 10)
+		if nanos > 0 {
+			fraction := strconv.FormatUint(nanos+uint64(time.Second), 10)[1:]
+			buf = append(buf, '.')
+			buf = append(buf, strings.TrimRight(fraction, "0")...)
+		}
+		buf = append(buf, 'S')
+	}
+	return buf
+}
+
+// ISODuration is a Duration which serializes as an ISO 8601 duration, e.g. "PT1H30M".
+//
+// It parses the same durations as Duration.
+//
+// swagger:strfmt duration
+type ISODuration time.Duration
+
+// String converts this duration to an ISO 8601 duration
+func (d ISODuration) String() string {
+	return FormatISODuration(time.Duration(d))
+}
+
+// MarshalText turns this instance into text
+func (d ISODuration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (d *ISODuration) UnmarshalText(data []byte) error {
+	dd, err := ParseDuration(string(data))
+	if err != nil {
+		return err
+	}
+	*d = ISODuration(dd)
+	return nil
+}
+
+// Scan reads an ISODuration value from database driver type.
+//
+// Integers are read as nanoseconds, like for Duration.
+func (d *ISODuration) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return d.UnmarshalText(v)
+	case string:
+		return d.UnmarshalText([]byte(v))
+	case int64:
+		*d = ISODuration(v)
+	case float64:
+		*d = ISODuration(int64(v))
+	case nil:
+		*d = ISODuration(0)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.ISODuration from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts ISODuration to a primitive value ready to be written to a database.
+func (d ISODuration) Value() (driver.Value, error) {
+	return driver.Value(d.String()), nil
+}
+
+// MarshalJSON returns the ISODuration as JSON
+func (d ISODuration) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	d.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the ISODuration to a easyjson.Writer
+func (d ISODuration) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(d.String())
+}
+
+// UnmarshalJSON sets the ISODuration from JSON
+func (d *ISODuration) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	d.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the ISODuration from a easyjson.Lexer
+func (d *ISODuration) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		dd, err := ParseDuration(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*d = ISODuration(dd)
+	}
+}
+
+// GetBSON returns the ISODuration as a bson.M{} map.
+func (d *ISODuration) GetBSON() (interface{}, error) {
+	return bson.M{"data": d.String()}, nil
+}
+
+// SetBSON sets the ISODuration from raw bson data, either an ISO 8601
+// duration or a number of nanoseconds stored by a Duration
+func (d *ISODuration) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	switch data := m["data"].(type) {
+	case string:
+		return d.UnmarshalText([]byte(data))
+	case int64:
+		*d = ISODuration(data)
+		return nil
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as ISODuration")
+}
@@ -0,0 +1,148 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration_ISO(t *testing.T) {
+	const day = 24 * time.Hour
+
+	testCases := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"PT1H30M", time.Hour + 30*time.Minute},
+		{"P2DT3H", 2*day + 3*time.Hour},
+		{"P4DT12H30M5S", 4*day + 12*time.Hour + 30*time.Minute + 5*time.Second},
+		{"PT36H", 36 * time.Hour},
+		{"PT0S", 0},
+		{"P0D", 0},
+		{"P2W", 14 * day},
+		{"P1Y", 365 * day},
+		{"P1M", 30 * day},
+		{"PT1M", time.Minute},
+		{"P1Y1D", 366 * day},
+		{"PT1H1S", time.Hour + time.Second},
+		{"PT1.5S", 1500 * time.Millisecond},
+		{"PT0,000000001S", time.Nanosecond},
+		{"PT0.0000000019S", time.Nanosecond},
+		{"PT1.5H", 90 * time.Minute},
+		{"P0.5D", 12 * time.Hour},
+		{"-PT1M30S", -90 * time.Second},
+		{"+PT1M", time.Minute},
+	}
+	for _, tc := range testCases {
+		got, err := ParseDuration(tc.in)
+		if assert.NoError(t, err, tc.in) {
+			assert.Equal(t, tc.want, got, tc.in)
+		}
+	}
+
+	for _, in := range []string{
+		"P", "PT", "P1YT", "PT1D", "P2D1Y", "P1D2H", "P2S", "P1Y2W", "P1W1D", "PT1H1H",
+		"P1.5DT1H", "PT1.S", "PT.5S", "PT1", "P-1D", "P1DT", "PTT1S",
+		"P300Y", "P9999999999999999999D",
+	} {
+		_, err := ParseDuration(in)
+		assert.Error(t, err, in)
+		assert.False(t, IsDuration(in), in)
+	}
+}
+
+func TestFormatISODuration(t *testing.T) {
+	testCases := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "PT0S"},
+		{time.Nanosecond, "PT0.000000001S"},
+		{1500 * time.Millisecond, "PT1.5S"},
+		{time.Hour + 30*time.Minute, "PT1H30M"},
+		{36*time.Hour + 5*time.Second, "PT36H5S"},
+		{-90 * time.Second, "-PT1M30S"},
+		{math.MaxInt64, "PT2562047H47M16.854775807S"},
+	}
+	for _, tc := range testCases {
+		got := FormatISODuration(tc.in)
+		assert.Equal(t, tc.want, got)
+
+		back, err := ParseDuration(got)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.in, back)
+	}
+
+	// the smallest duration is formatted, though its opposite is out of range
+	assert.Equal(t, "-PT2562047H47M16.854775808S", FormatISODuration(math.MinInt64))
+}
+
+func TestISODuration(t *testing.T) {
+	d := ISODuration(time.Hour + 30*time.Minute)
+	assert.Equal(t, "PT1H30M", d.String())
+
+	txt, err := d.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "PT1H30M", string(txt))
+
+	b, err := d.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"PT1H30M"`, string(b))
+
+	val, err := d.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "PT1H30M", val)
+
+	// any duration syntax is read
+	var back ISODuration
+	assert.NoError(t, back.UnmarshalText([]byte("1h30m")))
+	assert.Equal(t, d, back)
+	assert.Error(t, back.UnmarshalText([]byte("yada")))
+
+	back = 0
+	assert.NoError(t, back.UnmarshalJSON([]byte(`"PT90M"`)))
+	assert.Equal(t, d, back)
+	assert.Error(t, back.UnmarshalJSON([]byte(`"P2D1Y"`)))
+	assert.Error(t, back.UnmarshalJSON([]byte(`12`)))
+
+	for _, raw := range []interface{}{"PT1H30M", []byte("PT1H30M"), int64(d), float64(d)} {
+		back = 0
+		assert.NoError(t, back.Scan(raw))
+		assert.Equal(t, d, back)
+	}
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, ISODuration(0), back)
+	assert.Error(t, back.Scan(true))
+
+	bsonData, err := bson.Marshal(&d)
+	assert.NoError(t, err)
+	back = 0
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, d, back)
+
+	// durations stored by Duration are read as well
+	dur := Duration(d)
+	bsonData, err = bson.Marshal(&dur)
+	assert.NoError(t, err)
+	back = 0
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, d, back)
+}
@@ -182,14 +182,81 @@ func TestDurationParser(t *testing.T) {
 		testDurationSQLScanner(t, dur)
 	}
 }
+func TestDurationParser_tokens(t *testing.T) {
+	testcases := map[string]time.Duration{
+		"1.5 hours":            90 * time.Minute,
+		"1.5h":                 90 * time.Minute,
+		".5 day":               12 * time.Hour,
+		"2. days":              48 * time.Hour,
+		"-3 days":              -72 * time.Hour,
+		"+3 days":              72 * time.Hour,
+		"-2 days, 4h":          -52 * time.Hour,
+		"1 week 2 days":        9 * 24 * time.Hour,
+		"1 WEEK 2 Days":        9 * 24 * time.Hour,
+		"1d2h3m":               26*time.Hour + 3*time.Minute,
+		"1 hour, 30 minutes":   90 * time.Minute,
+		"0.3 seconds":          300 * time.Millisecond,
+		"0.000000001 seconds":  time.Nanosecond,
+		"1.0000000001 seconds": time.Second,
+		"2\thrs ":              2 * time.Hour,
+		"15250 weeks":          15250 * 7 * 24 * time.Hour,
+		"1 microsecond 1 nano": time.Microsecond + time.Nanosecond,
+		"3 secs":               3 * time.Second,
+		" 1h30m\n":             90 * time.Minute,
+		" PT1H ":               time.Hour,
+	}
+	for str, dur := range testcases {
+		testDurationParser(t, str, dur)
+	}
+
+	for _, str := range []string{
+		"", "-", "days", "3", "3 dayz", "3 days and some junk", "5 days junk", "1 day,", "1 day,, 2 days",
+		"1 day - 2 days", "1 day -2 days", "--1 day", "1..5 days", ". days",
+		"15251 weeks", "9223372036854775807 ns 1 ns", "99999999999999999999 ns",
+	} {
+		_, err := ParseDuration(str)
+		assert.Error(t, err, str)
+		assert.IsType(t, &FormatError{}, err, str)
+	}
+}
+
+func TestDurationParser_baseline(t *testing.T) {
+	// inputs accepted by former releases
+	testcases := map[string]time.Duration{
+		"1nanosec":      time.Nanosecond,
+		"2 nanosecs":    2 * time.Nanosecond,
+		"1microsec":     time.Microsecond,
+		"2 microsecs":   2 * time.Microsecond,
+		"1millisec":     time.Millisecond,
+		"2 millisecs":   2 * time.Millisecond,
+		"3 seconds":     3 * time.Second,
+		"3 mins":        3 * time.Minute,
+		"3 minutes":     3 * time.Minute,
+		"3 hours":       3 * time.Hour,
+		"3 days":        72 * time.Hour,
+		"3 weeks":       21 * 24 * time.Hour,
+		"1 day 2 hours": 26 * time.Hour,
+		"  3 days":      72 * time.Hour,
+		"3 days  ":      72 * time.Hour,
+		"\t3 days\n":    72 * time.Hour,
+		" 3h ":          3 * time.Hour,
+	}
+	for str, dur := range testcases {
+		testDurationParser(t, str, dur)
+	}
+
+	_, err := ParseDuration("  3 dayz")
+	assertFormatError(t, err, "duration", 4, ReasonInvalidSyntax)
+}
+
 func TestIsDuration_Caveats(t *testing.T) {
 	// This works too
 	e := IsDuration("45 weeks")
 	assert.True(t, e)
 
-	// This works too
+	// This does not work: units are not matched by prefix
 	e = IsDuration("45 weekz")
-	assert.True(t, e)
+	assert.False(t, e)
 
 	// This works too
 	e = IsDuration("12 hours")
@@ -0,0 +1,72 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import "fmt"
+
+// Reason is a machine readable code explaining why a value does not validate against a format
+type Reason string
+
+const (
+	// ReasonInvalid is used when a validator can't tell more precisely what is wrong with the value
+	ReasonInvalid Reason = "invalid"
+	// ReasonInvalidLength is used when the value is too short or too long for the format
+	ReasonInvalidLength Reason = "invalid length"
+	// ReasonInvalidCharacter is used when the value contains a character which is not allowed at this position
+	ReasonInvalidCharacter Reason = "invalid character"
+	// ReasonInvalidSyntax is used when the value does not follow the grammar of the format
+	ReasonInvalidSyntax Reason = "invalid syntax"
+	// ReasonInvalidVersion is used when the version nibble of a UUID does not match the format
+	ReasonInvalidVersion Reason = "invalid version"
+	// ReasonInvalidVariant is used when the variant bits of a UUID do not match the format
+	ReasonInvalidVariant Reason = "invalid variant"
+	// ReasonOutOfRange is used when a component of the value (e.g. a month or an hour) is out of range
+	ReasonOutOfRange Reason = "out of range"
+)
+
+// FormatError is the error returned by error-returning validators.
+//
+// It tells which format was checked, the offending value, the byte offset
+// in this value where validation failed and the reason for the failure.
+// Offset is -1 when the failure can't be attributed to a position in the value.
+type FormatError struct {
+	Name   string
+	Value  string
+	Offset int
+	Reason Reason
+}
+
+func (e *FormatError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("%q is not a valid %s: %s", e.Value, e.Name, e.Reason)
+	}
+	return fmt.Sprintf("%q is not a valid %s: %s at offset %d", e.Value, e.Name, e.Reason, e.Offset)
+}
+
+func newFormatError(name, value string, offset int, reason Reason) *FormatError {
+	return &FormatError{Name: name, Value: value, Offset: offset, Reason: reason}
+}
+
+// WrapValidator turns a boolean validator into an error-returning validator.
+//
+// Values rejected by the wrapped validator produce a FormatError with reason ReasonInvalid.
+func WrapValidator(validator Validator) ValidatorE {
+	return func(str string) error {
+		if validator(str) {
+			return nil
+		}
+		return newFormatError("", str, -1, ReasonInvalid)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatError_Error(t *testing.T) {
+	err := &FormatError{Name: "uuid4", Value: "x", Offset: 3, Reason: ReasonInvalidVersion}
+	assert.Equal(t, `"x" is not a valid uuid4: invalid version at offset 3`, err.Error())
+
+	err = &FormatError{Name: "email", Value: "x", Offset: -1, Reason: ReasonInvalid}
+	assert.Equal(t, `"x" is not a valid email: invalid`, err.Error())
+}
+
+func TestWrapValidator(t *testing.T) {
+	v := WrapValidator(isTestFormat)
+	assert.NoError(t, v("tfa"))
+
+	err := v("ffa")
+	if assert.IsType(t, &FormatError{}, err) {
+		fe := err.(*FormatError)
+		assert.Equal(t, "ffa", fe.Value)
+		assert.Equal(t, -1, fe.Offset)
+		assert.Equal(t, ReasonInvalid, fe.Reason)
+	}
+}
+
+func assertFormatError(t *testing.T, err error, name string, offset int, reason Reason) {
+	if assert.IsType(t, &FormatError{}, err, "expected a format error for %s", name) {
+		fe := err.(*FormatError)
+		assert.Equal(t, name, fe.Name)
+		assert.Equal(t, offset, fe.Offset, "unexpected offset for %q", fe.Value)
+		assert.Equal(t, reason, fe.Reason, "unexpected reason for %q", fe.Value)
+	}
+}
+
+func TestValidateUUID_errors(t *testing.T) {
+	assert.NoError(t, ValidateUUID("a8098c1a-f86e-11da-bd1a-00112444be1e"))
+	assert.NoError(t, ValidateUUID("A8098C1AF86E11DABD1A00112444BE1E"))
+	assert.NoError(t, ValidateUUID4("025b0d74-00a2-4048-bf57-227c5111bb34"))
+
+	assertFormatError(t, ValidateUUID("a8098c1a-f86e-11da-bd1a-00112444be1"), "uuid", 35, ReasonInvalidLength)
+	assertFormatError(t, ValidateUUID("a8098c1a-f86e-11da-bd1a-00112444be1e0"), "uuid", 36, ReasonInvalidLength)
+	assertFormatError(t, ValidateUUID("a8098c1a-f86e-11da-bd1a-00112444bx1e"), "uuid", 33, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateUUID("a8098c1a--f86e-11da-bd1a-00112444be1e"), "uuid", 9, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateUUID3("a8098c1a-f86e-11da-bd1a-00112444be1e"), "uuid3", 14, ReasonInvalidVersion)
+	assertFormatError(t, ValidateUUID4("025b0d74-00a2-5048-bf57-227c5111bb34"), "uuid4", 14, ReasonInvalidVersion)
+	assertFormatError(t, ValidateUUID5("886313e1-3b8a-5372-cb90-0c9aee199e5d"), "uuid5", 19, ReasonInvalidVariant)
+	assertFormatError(t, ValidateUUID(""), "uuid", 0, ReasonInvalidLength)
+}
+
+func TestValidateUUID_matchesPatterns(t *testing.T) {
+	values := []string{
+		"a8098c1a-f86e-11da-bd1a-00112444be1e",
+		"bcd02e22-68f0-3046-a512-327cca9def8f",
+		"bcd02e22-68f0-3046-c512-327cca9def8f",
+		"025b0d74-00a2-4048-bf57-227c5111bb34",
+		"025B0D74-00A2-4048-BF57-227C5111BB34",
+		"025b0d7400a24048bf57227c5111bb34",
+		"025b0d74-00a24048-bf57227c5111bb34",
+		"886313e1-3b8a-5372-9b90-0c9aee199e5d",
+		"886313e1-3b8a-5372-0b90-0c9aee199e5d",
+		"886313e1-3b8a-5372-9b90-0c9aee199e5",
+		"886313e1-3b8a-5372-9b90-0c9aee199e5d-",
+		"886313e1-3b8a-5372-9b90--0c9aee199e5d",
+		"-886313e1-3b8a-5372-9b90-0c9aee199e5d",
+		"886313e1-3b8a-5372-9b90-0c9aee199e5g",
+		"not-a-uuid",
+		"",
+	}
+	validators := []struct {
+		pattern  string
+		validate func(string) error
+	}{
+		{UUIDPattern, ValidateUUID},
+		{UUID3Pattern, ValidateUUID3},
+		{UUID4Pattern, ValidateUUID4},
+		{UUID5Pattern, ValidateUUID5},
+	}
+	for _, v := range validators {
+		rx := regexp.MustCompile(v.pattern)
+		for _, value := range values {
+			assert.Equal(t, rx.MatchString(value), v.validate(value) == nil, "pattern %s on %q", v.pattern, value)
+		}
+	}
+}
+
+func TestValidateHostname_errors(t *testing.T) {
+	assert.NoError(t, ValidateHostname("somewhere.com"))
+	assert.NoError(t, ValidateHostname("a"))
+	assert.NoError(t, ValidateHostname("a-1.b2"))
+
+	assertFormatError(t, ValidateHostname("somewhere.com!"), "hostname", 13, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateHostname("1somewhere.com"), "hostname", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateHostname("somewhere-.com"), "hostname", 9, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateHostname("somewhere..com"), "hostname", 10, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateHostname(""), "hostname", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateHostname("a"+strings.Repeat("b", 63)+".com"), "hostname", 63, ReasonInvalidLength)
+	assertFormatError(t, ValidateHostname(strings.Repeat("a.", 128)+"a"), "hostname", 255, ReasonInvalidLength)
+
+	rx := regexp.MustCompile(HostnamePattern)
+	for _, value := range []string{"a", "a.b", "a-.b", "-a", "a.-b", "a.b.", ".a", "a_b", "ab-cd.e-f", "a1.b2", "a.1"} {
+		assert.Equal(t, rx.MatchString(value), IsHostname(value), "hostname %q", value)
+	}
+}
+
+func TestValidateDate_errors(t *testing.T) {
+	assert.NoError(t, ValidateDate("2017-12-22"))
+
+	assertFormatError(t, ValidateDate("2017-1-1"), "date", 6, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateDate("2017-12-2"), "date", 9, ReasonInvalidLength)
+	assertFormatError(t, ValidateDate("2017-12-222"), "date", 10, ReasonInvalidLength)
+	assertFormatError(t, ValidateDate("2017/12/22"), "date", 4, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateDate("2017-13-22"), "date", 5, ReasonOutOfRange)
+	assertFormatError(t, ValidateDate("2017-02-29"), "date", 8, ReasonOutOfRange)
+}
+
+func TestValidateDateTime_errors(t *testing.T) {
+	assert.NoError(t, ValidateDateTime("2014-12-15T08:00:00.000Z"))
+
+	assertFormatError(t, ValidateDateTime("zor"), "datetime", 3, ReasonInvalidLength)
+	assertFormatError(t, ValidateDateTime("2014-12-15"), "datetime", 10, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateTime("2014-13-15T08:00:00Z"), "datetime", 5, ReasonOutOfRange)
+	assertFormatError(t, ValidateDateTime("1972-12-31Tx"), "datetime", 11, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDateTime("1972-12-31T24:40:00.000Z"), "datetime", 11, ReasonOutOfRange)
+	assertFormatError(t, ValidateDateTime("1972-12-31T23:63:00.000Z"), "datetime", 14, ReasonOutOfRange)
+	assertFormatError(t, ValidateDateTime("1972-12-31T23:59:60.000Z"), "datetime", 17, ReasonOutOfRange)
+}
+
+func TestValidateDuration_errors(t *testing.T) {
+	assert.NoError(t, ValidateDuration("3 weeks"))
+	assertFormatError(t, ValidateDuration("yada"), "duration", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateDuration(""), "duration", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDuration("3 dayz"), "duration", 2, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDuration("5 days and some junk"), "duration", 7, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateDuration("5 days 3"), "duration", 8, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDuration("5 days,"), "duration", 7, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDuration("1 day 99999999999 weeks"), "duration", 6, ReasonOutOfRange)
+	assertFormatError(t, ValidateDuration("PT1D"), "duration", 3, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateDuration("P300Y"), "duration", 1, ReasonOutOfRange)
+}
+
+func TestValidateBSONObjectID_errors(t *testing.T) {
+	assert.NoError(t, ValidateBSONObjectID("507f1f77bcf86cd799439011"))
+
+	assertFormatError(t, ValidateBSONObjectID("507f1f77bcf86cd79943901"), "bsonobjectid", 23, ReasonInvalidLength)
+	assertFormatError(t, ValidateBSONObjectID("507f1f77bcf86cd7994390112"), "bsonobjectid", 24, ReasonInvalidLength)
+	assertFormatError(t, ValidateBSONObjectID("507f1f77bcf86cd79943901z"), "bsonobjectid", 23, ReasonInvalidCharacter)
+}
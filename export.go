@@ -0,0 +1,126 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SchemaDialect is the flavor of the schema fragments exported from a registry
+type SchemaDialect string
+
+const (
+	// OpenAPI2 exports fragments for the definitions of a Swagger 2.0 spec
+	OpenAPI2 SchemaDialect = "openapi-2.0"
+	// OpenAPI31 exports fragments for the components of an OpenAPI 3.1 spec
+	OpenAPI31 SchemaDialect = "openapi-3.1"
+	// JSONSchema202012 exports fragments for the $defs of a JSON Schema 2020-12 document
+	JSONSchema202012 SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+)
+
+// schemaFormatNames are the names used in specs for the formats registered
+// under a different name
+var schemaFormatNames = map[string]string{
+	"datetime": "date-time",
+}
+
+// Schema is the schema fragment describing a string format
+type Schema struct {
+	Type        string   `json:"type"`
+	Format      string   `json:"format"`
+	Description string   `json:"description,omitempty"`
+	Pattern     string   `json:"pattern,omitempty"`
+	MaxLength   int      `json:"maxLength,omitempty"`
+	Example     string   `json:"example,omitempty"`
+	Examples    []string `json:"examples,omitempty"`
+}
+
+// ExportSchema builds the schema fragment of a format in a dialect.
+//
+// OpenAPI 2.0 only supports a single example: the first one is exported.
+func ExportSchema(info FormatInfo, dialect SchemaDialect) (*Schema, error) {
+	name := info.Name
+	if std, ok := schemaFormatNames[name]; ok {
+		name = std
+	}
+	schema := &Schema{
+		Type:        "string",
+		Format:      name,
+		Description: info.Description,
+		Pattern:     info.Pattern,
+		MaxLength:   info.MaxLength,
+	}
+
+	switch dialect {
+	case OpenAPI2:
+		if len(info.Examples) > 0 {
+			schema.Example = info.Examples[0]
+		}
+	case OpenAPI31, JSONSchema202012:
+		schema.Examples = append([]string(nil), info.Examples...)
+	default:
+		return nil, fmt.Errorf("unsupported schema dialect %q", dialect)
+	}
+	return schema, nil
+}
+
+// ExportSchemas builds the schema fragments of all the formats of a registry,
+// indexed by the name of the format in specs.
+//
+// The registry must be a DescribingRegistry, as the schemas are built from the
+// metadata of its formats.
+func ExportSchemas(registry Registry, dialect SchemaDialect) (map[string]*Schema, error) {
+	r, ok := registry.(DescribingRegistry)
+	if !ok {
+		return nil, fmt.Errorf("registry %T does not describe its formats", registry)
+	}
+	infos := r.List()
+	schemas := make(map[string]*Schema, len(infos))
+	for _, info := range infos {
+		schema, err := ExportSchema(info, dialect)
+		if err != nil {
+			return nil, err
+		}
+		schemas[schema.Format] = schema
+	}
+	return schemas, nil
+}
+
+// ExportDocument renders the formats of a registry as a JSON document in a dialect.
+//
+// The fragments are found under "definitions" for OpenAPI 2.0, under
+// "components/schemas" for OpenAPI 3.1 and under "$defs" for JSON Schema,
+// so they may be referenced from or merged into a spec.
+func ExportDocument(registry Registry, dialect SchemaDialect) ([]byte, error) {
+	schemas, err := ExportSchemas(registry, dialect)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	switch dialect {
+	case OpenAPI2:
+		doc = map[string]interface{}{"definitions": schemas}
+	case OpenAPI31:
+		doc = map[string]interface{}{"components": map[string]interface{}{"schemas": schemas}}
+	case JSONSchema202012:
+		doc = struct {
+			Schema string             `json:"$schema"`
+			Defs   map[string]*Schema `json:"$defs"`
+		}{string(dialect), schemas}
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
@@ -0,0 +1,96 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportSchema(t *testing.T) {
+	info, _ := Default.(*defaultFormats).Describe("ssn")
+
+	schema, err := ExportSchema(info, OpenAPI2)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "string", schema.Type)
+		assert.Equal(t, "ssn", schema.Format)
+		assert.Equal(t, info.Pattern, schema.Pattern)
+		assert.Equal(t, 11, schema.MaxLength)
+		assert.Equal(t, info.Examples[0], schema.Example)
+		assert.Empty(t, schema.Examples)
+	}
+
+	for _, dialect := range []SchemaDialect{OpenAPI31, JSONSchema202012} {
+		schema, err = ExportSchema(info, dialect)
+		if assert.NoError(t, err) {
+			assert.Empty(t, schema.Example)
+			assert.Equal(t, info.Examples, schema.Examples)
+		}
+	}
+
+	info, _ = Default.(*defaultFormats).Describe("datetime")
+	schema, err = ExportSchema(info, OpenAPI2)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "date-time", schema.Format)
+	}
+
+	_, err = ExportSchema(info, SchemaDialect("raml"))
+	assert.Error(t, err)
+}
+
+func TestExportSchemas(t *testing.T) {
+	schemas, err := ExportSchemas(Default, JSONSchema202012)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, schemas, len(Default.(*defaultFormats).List()))
+	for name, schema := range schemas {
+		assert.Equal(t, name, schema.Format)
+		// the exported format must resolve to the registered validator
+		assert.True(t, Default.ContainsName(schema.Format), "unknown format %s", schema.Format)
+		for _, example := range schema.Examples {
+			assert.True(t, Default.Validates(schema.Format, example), "example %q of %s is not valid", example, name)
+		}
+	}
+
+	_, err = ExportSchemas(Default, SchemaDialect(""))
+	assert.Error(t, err)
+}
+
+func TestExportDocument(t *testing.T) {
+	registry := NewSeededFormats(nil, nil).(*defaultFormats)
+	registry.AddWithInfo("ssn", new(SSN), func(s string) error { return Default.(*defaultFormats).ValidateE("ssn", s) }, FormatInfo{
+		Pattern:  `^\d{3}-\d{2}-\d{4}$`,
+		Examples: []string{"111-11-1111"},
+	})
+
+	expected := map[SchemaDialect]string{
+		OpenAPI2:         `{"definitions":{"ssn":{"type":"string","format":"ssn","pattern":"^\\d{3}-\\d{2}-\\d{4}$","example":"111-11-1111"}}}`,
+		OpenAPI31:        `{"components":{"schemas":{"ssn":{"type":"string","format":"ssn","pattern":"^\\d{3}-\\d{2}-\\d{4}$","examples":["111-11-1111"]}}}}`,
+		JSONSchema202012: `{"$schema":"https://json-schema.org/draft/2020-12/schema","$defs":{"ssn":{"type":"string","format":"ssn","pattern":"^\\d{3}-\\d{2}-\\d{4}$","examples":["111-11-1111"]}}}`,
+	}
+	for dialect, doc := range expected {
+		b, err := ExportDocument(registry, dialect)
+		if assert.NoError(t, err) {
+			assert.True(t, json.Valid(b))
+			assert.JSONEq(t, doc, string(b), "unexpected %s document", dialect)
+		}
+	}
+
+	_, err := ExportDocument(registry, SchemaDialect("raml"))
+	assert.Error(t, err)
+}
@@ -19,7 +19,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
-	"time"
+	"sync/atomic"
 
 	"github.com/go-openapi/errors"
 	"github.com/mitchellh/mapstructure"
@@ -31,6 +31,11 @@ var Default = NewSeededFormats(nil, nil)
 // Validator represents a validator for a string format.
 type Validator func(string) bool
 
+// ValidatorE represents a validator for a string format which tells why a string is invalid.
+//
+// Validators of the strfmt package return a *FormatError.
+type ValidatorE func(string) error
+
 // Format represents a string format.
 //
 // All implementations of Format provide a string representation and text
@@ -42,6 +47,9 @@ type Format interface {
 }
 
 // Registry is a registry of string formats, with a validation method.
+//
+// The registries of this package implement the optional interfaces RegistryE,
+// DescribingRegistry, FreezableRegistry and ConfigurableRegistry as well.
 type Registry interface {
 	Add(string, Format, Validator) bool
 	DelByName(string) bool
@@ -52,11 +60,131 @@ type Registry interface {
 	MapStructureHookFunc() mapstructure.DecodeHookFunc
 }
 
+// RegistryE is a registry of string formats with validators telling why a string is invalid
+type RegistryE interface {
+	Registry
+	AddE(string, Format, ValidatorE) bool
+	ValidateE(string, string) error
+	ValidatingMapStructureHookFunc() mapstructure.DecodeHookFunc
+}
+
+// DescribingRegistry is a registry of string formats which keeps their metadata
+type DescribingRegistry interface {
+	Registry
+	AddWithInfo(string, Format, ValidatorE, FormatInfo) bool
+	Describe(string) (FormatInfo, bool)
+	List() []FormatInfo
+}
+
+// FreezableRegistry is a registry of string formats which may be copied and made read-only.
+//
+// Once a registry is frozen, the methods adding, deleting or configuring
+// formats leave it untouched and return false.
+type FreezableRegistry interface {
+	Registry
+	Clone() Registry
+	Freeze()
+}
+
+// ConfigurableRegistry is a registry of string formats with options on how it parses them
+type ConfigurableRegistry interface {
+	Registry
+	Canonicalize(string) bool
+	UseDateTimeParser(string, DateTimeParser) bool
+}
+
+// AddWithInfo adds a format with its metadata to a registry, return true if this
+// was a new item instead of a replacement.
+//
+// The metadata are left out when the registry is not a DescribingRegistry.
+func AddWithInfo(registry Registry, name string, strfmt Format, validator ValidatorE, info FormatInfo) bool {
+	if r, ok := registry.(DescribingRegistry); ok {
+		return r.AddWithInfo(name, strfmt, validator, info)
+	}
+	return registry.Add(name, strfmt, func(str string) bool { return validator(str) == nil })
+}
+
+// FormatInfo holds the metadata of a format, to document it or to generate
+// validators and error messages in other languages.
+type FormatInfo struct {
+	// Name is the name the format was registered with
+	Name string
+	// Description is a human readable description of the format
+	Description string
+	// Pattern is an ECMA-262 regular expression matching valid values.
+	// It is empty when the format can't be expressed as a regular expression and
+	// may accept values the format validator rejects (e.g. February 30th).
+	Pattern string
+	// MaxLength is the maximum length of a valid value, 0 when unbounded
+	MaxLength int
+	// Examples are valid values
+	Examples []string
+	// InvalidExamples are values which are not valid
+	InvalidExamples []string
+}
+
+func (i FormatInfo) clone() FormatInfo {
+	i.Examples = append([]string(nil), i.Examples...)
+	i.InvalidExamples = append([]string(nil), i.InvalidExamples...)
+	return i
+}
+
 type knownFormat struct {
-	Name      string
-	OrigName  string
-	Type      reflect.Type
-	Validator Validator
+	Name       string
+	OrigName   string
+	Type       reflect.Type
+	Validator  Validator
+	ValidatorE ValidatorE
+	Info       FormatInfo
+	Canonical  bool
+	Parser     *DateTimeParser
+}
+
+// canonicalizer is implemented by the formats with several representations of
+// the same value, such as UUIDs in upper or lower case, to rewrite them in their
+// canonical representation
+type canonicalizer interface {
+	canonicalize()
+}
+
+// dateTimeDecoder is implemented by the date-time types, which a registry may
+// parse with another DateTimeParser than the lenient one of UnmarshalText
+type dateTimeDecoder interface {
+	parseDateTime(DateTimeParser, string) error
+}
+
+// decode unmarshals str into a new value of the format type, with the parser of
+// the format for date-times, rewritten in its canonical representation when the
+// format is canonicalized
+func (v *knownFormat) decode(name, str string) (interface{}, error) {
+	nw := reflect.New(v.Type).Interface()
+	if d, ok := nw.(dateTimeDecoder); ok && v.Parser != nil {
+		if err := d.parseDateTime(*v.Parser, str); err != nil {
+			return nil, err
+		}
+		return nw, nil
+	}
+	dec, ok := nw.(encoding.TextUnmarshaler)
+	if !ok {
+		return nil, errors.InvalidTypeName(name)
+	}
+	if err := dec.UnmarshalText([]byte(str)); err != nil {
+		return nil, err
+	}
+	if c, ok := nw.(canonicalizer); ok && v.Canonical {
+		c.canonicalize()
+	}
+	return nw, nil
+}
+
+// validate runs the error-returning validator, naming the format in the
+// returned *FormatError as it was asked for by the caller
+func (v *knownFormat) validate(name, data string) error {
+	err := v.ValidatorE(data)
+	if fe, ok := err.(*FormatError); ok {
+		fe.Name = name
+	}
+	return err
 }
 
 // NameNormalizer is a function that normalizes a format name.
@@ -67,15 +195,133 @@ func DefaultNameNormalizer(name string) string {
 	return strings.Replace(name, "-", "", -1)
 }
 
+// formatsSnapshot is an immutable view of the formats known to a registry.
+//
+// Readers load the current snapshot without locking, writers build a new
+// snapshot from a copy of the current one and swap it in.
+//
+// The hidden names are the formats of the parent registry deleted from a child.
+type formatsSnapshot struct {
+	data   []knownFormat
+	byName map[string]*knownFormat
+	byType map[reflect.Type]*knownFormat
+	hidden map[string]bool
+}
+
+func newFormatsSnapshot(data []knownFormat, hidden map[string]bool) *formatsSnapshot {
+	s := &formatsSnapshot{
+		data:   data,
+		byName: make(map[string]*knownFormat, len(data)),
+		byType: make(map[reflect.Type]*knownFormat, len(data)),
+		hidden: hidden,
+	}
+	// when several entries share a key, the first one wins like a linear scan would
+	for i := range data {
+		v := &data[i]
+		if _, ok := s.byName[v.Name]; !ok {
+			s.byName[v.Name] = v
+		}
+		if _, ok := s.byType[v.Type]; !ok {
+			s.byType[v.Type] = v
+		}
+	}
+	return s
+}
+
 type defaultFormats struct {
-	sync.Mutex
-	data          []knownFormat
+	sync.Mutex    // serializes writers
+	snapshot      atomic.Value
 	normalizeName NameNormalizer
+	parent        *defaultFormats
+	frozen        bool
+}
+
+func (f *defaultFormats) load() *formatsSnapshot {
+	return f.snapshot.Load().(*formatsSnapshot)
 }
 
-// NewFormats creates a new formats registry seeded with the values from the default
+// find returns the format with the normalized name nme, looking it up in the
+// parent registries when this one doesn't know it
+func (f *defaultFormats) find(nme string) (*knownFormat, bool) {
+	s := f.load()
+	if v, ok := s.byName[nme]; ok {
+		return v, true
+	}
+	if f.parent == nil || s.hidden[nme] {
+		return nil, false
+	}
+	return f.parent.find(nme)
+}
+
+// findType returns the format with the type tpe, looking it up in the parent
+// registries when this one doesn't know it
+func (f *defaultFormats) findType(tpe reflect.Type) (*knownFormat, bool) {
+	if v, ok := f.load().byType[tpe]; ok {
+		return v, true
+	}
+	if f.parent == nil {
+		return nil, false
+	}
+	v, ok := f.parent.findType(tpe)
+	if !ok {
+		return nil, false
+	}
+	// the format of the parent may be overridden or deleted here
+	if w, _ := f.find(v.Name); w != v {
+		return nil, false
+	}
+	return v, true
+}
+
+// all returns the formats of this registry and the ones inherited from its
+// parents: inherited formats come first, in the order of the parent, unless
+// they are overridden in place or deleted
+func (f *defaultFormats) all() []*knownFormat {
+	s := f.load()
+	var result []*knownFormat
+	seen := make(map[string]bool, len(s.data))
+	if f.parent != nil {
+		for _, v := range f.parent.all() {
+			switch w, ok := s.byName[v.Name]; {
+			case ok:
+				result = append(result, w)
+				seen[v.Name] = true
+			case !s.hidden[v.Name]:
+				result = append(result, v)
+			}
+		}
+	}
+	for i := range s.data {
+		v := &s.data[i]
+		if !seen[v.Name] && s.byName[v.Name] == v {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// NewFormats creates a new formats registry layered on the default one: it
+// knows the formats of Default, including the ones added to it later on, and
+// formats added to or deleted from it don't affect Default.
 func NewFormats() Registry {
-	return NewSeededFormats(Default.(*defaultFormats).data, nil)
+	return NewLayeredFormats(Default)
+}
+
+// NewLayeredFormats creates a new formats registry falling back to parent for
+// the formats it doesn't know.
+//
+// The lookups in parent are live: formats added to or deleted from parent
+// later on are seen by the new registry, unless it overrides or deletes them.
+// The new registry uses the name normalizer of parent, which must be created
+// by this package.
+func NewLayeredFormats(parent Registry) Registry {
+	p := parent.(*defaultFormats)
+	f := &defaultFormats{
+		normalizeName: p.normalizeName,
+		parent:        p,
+	}
+	f.snapshot.Store(newFormatsSnapshot(nil, nil))
+	return f
 }
 
 // NewSeededFormats creates a new formats registry
@@ -85,87 +331,114 @@ func NewSeededFormats(seeds []knownFormat, normalizer NameNormalizer) Registry {
 	}
 	// copy here, don't modify original
 	d := append([]knownFormat(nil), seeds...)
-	return &defaultFormats{
-		data:          d,
+	f := &defaultFormats{
 		normalizeName: normalizer,
 	}
+	f.snapshot.Store(newFormatsSnapshot(d, nil))
+	return f
 }
 
-// MapStructureHookFunc is a decode hook function for mapstructure
+// Clone returns a registry with the formats currently known to this one,
+// inherited ones included, which evolves independently from it.
+//
+// The clone is not frozen.
+func (f *defaultFormats) Clone() Registry {
+	all := f.all()
+	data := make([]knownFormat, 0, len(all))
+	for _, v := range all {
+		data = append(data, *v)
+	}
+	c := &defaultFormats{
+		normalizeName: f.normalizeName,
+	}
+	c.snapshot.Store(newFormatsSnapshot(data, nil))
+	return c
+}
+
+// Freeze makes this registry read-only: adding or deleting formats has no effect
+// and returns false from then on. It can't be undone, yet registries layered on
+// a frozen registry may be modified.
+//
+// A program may freeze Default once its own formats are registered, so
+// libraries can't replace them behind its back.
+func (f *defaultFormats) Freeze() {
+	f.Lock()
+	defer f.Unlock()
+	f.frozen = true
+}
+
+// MapStructureHookFunc is a decode hook function for mapstructure.
+//
+// Strings are decoded into any format type known to the registry (or a pointer
+// to such a type) with its UnmarshalText method.
 func (f *defaultFormats) MapStructureHookFunc() mapstructure.DecodeHookFunc {
+	return f.mapStructureHookFunc(false)
+}
+
+// ValidatingMapStructureHookFunc is a decode hook function for mapstructure
+// which, unlike MapStructureHookFunc, runs the format validator before decoding.
+func (f *defaultFormats) ValidatingMapStructureHookFunc() mapstructure.DecodeHookFunc {
+	return f.mapStructureHookFunc(true)
+}
+
+func (f *defaultFormats) mapStructureHookFunc(validate bool) mapstructure.DecodeHookFunc {
 	return func(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
 		if from.Kind() != reflect.String {
 			return data, nil
 		}
-		for _, v := range f.data {
-			tpe, _ := f.GetType(v.Name)
-			if to == tpe {
-				switch v.Name {
-				case "date":
-					d, err := time.Parse(RFC3339FullDate, data.(string))
-					if err != nil {
-						return nil, err
-					}
-					return Date(d), nil
-				case "datetime":
-					return ParseDateTime(data.(string))
-				case "duration":
-					dur, err := ParseDuration(data.(string))
-					if err != nil {
-						return nil, err
-					}
-					return Duration(dur), nil
-				case "uri":
-					return URI(data.(string)), nil
-				case "email":
-					return Email(data.(string)), nil
-				case "uuid":
-					return UUID(data.(string)), nil
-				case "uuid3":
-					return UUID3(data.(string)), nil
-				case "uuid4":
-					return UUID4(data.(string)), nil
-				case "uuid5":
-					return UUID5(data.(string)), nil
-				case "hostname":
-					return Hostname(data.(string)), nil
-				case "ipv4":
-					return IPv4(data.(string)), nil
-				case "ipv6":
-					return IPv6(data.(string)), nil
-				case "mac":
-					return MAC(data.(string)), nil
-				case "isbn":
-					return ISBN(data.(string)), nil
-				case "isbn10":
-					return ISBN10(data.(string)), nil
-				case "isbn13":
-					return ISBN13(data.(string)), nil
-				case "creditcard":
-					return CreditCard(data.(string)), nil
-				case "ssn":
-					return SSN(data.(string)), nil
-				case "hexcolor":
-					return HexColor(data.(string)), nil
-				case "rgbcolor":
-					return RGBColor(data.(string)), nil
-				case "byte":
-					return Base64(data.(string)), nil
-				case "password":
-					return Password(data.(string)), nil
-				default:
-					return nil, errors.InvalidTypeName(v.Name)
-				}
+		tpe := to
+		if tpe.Kind() == reflect.Ptr {
+			tpe = tpe.Elem()
+		}
+		v, ok := f.findType(tpe)
+		if !ok {
+			return data, nil
+		}
+
+		str := reflect.ValueOf(data).String()
+		if validate {
+			if err := v.validate(v.OrigName, str); err != nil {
+				return nil, err
 			}
 		}
-		return data, nil
+
+		nw, err := v.decode(v.Name, str)
+		if err != nil {
+			return nil, err
+		}
+		if to.Kind() == reflect.Ptr {
+			return nw, nil
+		}
+		return reflect.ValueOf(nw).Elem().Interface(), nil
 	}
 }
 
-// Add adds a new format, return true if this was a new item instead of a replacement
+// Add adds a new format, return true if this was a new item instead of a replacement.
+//
+// A format inherited from a parent registry is overridden, which is a replacement.
 func (f *defaultFormats) Add(name string, strfmt Format, validator Validator) bool {
+	return f.add(name, strfmt, validator, WrapValidator(validator), FormatInfo{})
+}
+
+// AddE adds a new format with a validator telling why a value is invalid,
+// return true if this was a new item instead of a replacement
+func (f *defaultFormats) AddE(name string, strfmt Format, validator ValidatorE) bool {
+	return f.AddWithInfo(name, strfmt, validator, FormatInfo{})
+}
+
+// AddWithInfo adds a new format with its metadata, return true if this was a new item instead of a replacement.
+//
+// The name of the info is set to the name of the format.
+func (f *defaultFormats) AddWithInfo(name string, strfmt Format, validator ValidatorE, info FormatInfo) bool {
+	return f.add(name, strfmt, func(str string) bool { return validator(str) == nil }, validator, info)
+}
+
+func (f *defaultFormats) add(name string, strfmt Format, validator Validator, validatorE ValidatorE, info FormatInfo) bool {
 	f.Lock()
 	defer f.Unlock()
+	if f.frozen {
+		return false
+	}
 
 	nme := f.normalizeName(name)
 
@@ -174,98 +447,136 @@ func (f *defaultFormats) Add(name string, strfmt Format, validator Validator) bo
 		tpe = tpe.Elem()
 	}
 
-	for i := range f.data {
-		v := &f.data[i]
+	info = info.clone()
+	info.Name = name
+
+	s := f.load()
+	data := append([]knownFormat(nil), s.data...)
+	for i := range data {
+		v := &data[i]
 		if v.Name == nme {
 			v.Type = tpe
 			v.Validator = validator
+			v.ValidatorE = validatorE
+			v.Info = info
+			v.Canonical = false
+			v.Parser = nil
+			f.snapshot.Store(newFormatsSnapshot(data, s.hidden))
 			return false
 		}
 	}
 
+	_, inherited := f.find(nme)
+	hidden := s.hidden
+	if hidden[nme] {
+		// the format deleted from the parent is overridden instead
+		hidden = copyHidden(hidden)
+		delete(hidden, nme)
+	}
+
 	// turns out it's new after all
-	f.data = append(f.data, knownFormat{Name: nme, OrigName: name, Type: tpe, Validator: validator})
-	return true
+	data = append(data, knownFormat{Name: nme, OrigName: name, Type: tpe, Validator: validator, ValidatorE: validatorE, Info: info})
+	f.snapshot.Store(newFormatsSnapshot(data, hidden))
+	return !inherited
 }
 
-// GetType gets the type for the specified name
-func (f *defaultFormats) GetType(name string) (reflect.Type, bool) {
+func copyHidden(hidden map[string]bool) map[string]bool {
+	c := make(map[string]bool, len(hidden)+1)
+	for k, v := range hidden {
+		c[k] = v
+	}
+	return c
+}
+
+// del removes the first format matching the predicate, returns true when an item was actually removed.
+//
+// A format inherited from a parent registry is hidden from this one, the parent is left untouched.
+func (f *defaultFormats) del(match func(*knownFormat) bool) bool {
 	f.Lock()
 	defer f.Unlock()
-	nme := f.normalizeName(name)
-	for _, v := range f.data {
-		if v.Name == nme {
-			return v.Type, true
+	if f.frozen {
+		return false
+	}
+
+	for _, v := range f.all() {
+		if !match(v) {
+			continue
+		}
+		s := f.load()
+		d := make([]knownFormat, 0, len(s.data))
+		for _, w := range s.data {
+			if w.Name != v.Name {
+				d = append(d, w)
+			}
 		}
+		hidden := s.hidden
+		if f.parent != nil {
+			if _, ok := f.parent.find(v.Name); ok {
+				hidden = copyHidden(hidden)
+				hidden[v.Name] = true
+			}
+		}
+		f.snapshot.Store(newFormatsSnapshot(d, hidden))
+		return true
+	}
+	return false
+}
+
+// GetType gets the type for the specified name
+func (f *defaultFormats) GetType(name string) (reflect.Type, bool) {
+	if v, ok := f.find(f.normalizeName(name)); ok {
+		return v.Type, true
 	}
 	return nil, false
 }
 
 // DelByName removes the format by the specified name, returns true when an item was actually removed
 func (f *defaultFormats) DelByName(name string) bool {
-	f.Lock()
-	defer f.Unlock()
-
 	nme := f.normalizeName(name)
-
-	for i, v := range f.data {
-		if v.Name == nme {
-			f.data[i] = knownFormat{} // release
-			f.data = append(f.data[:i], f.data[i+1:]...)
-			return true
-		}
-	}
-	return false
+	return f.del(func(v *knownFormat) bool { return v.Name == nme })
 }
 
 // DelByType removes the specified format, returns true when an item was actually removed
 func (f *defaultFormats) DelByFormat(strfmt Format) bool {
-	f.Lock()
-	defer f.Unlock()
-
 	tpe := reflect.TypeOf(strfmt)
 	if tpe.Kind() == reflect.Ptr {
 		tpe = tpe.Elem()
 	}
-
-	for i, v := range f.data {
-		if v.Type == tpe {
-			f.data[i] = knownFormat{} // release
-			f.data = append(f.data[:i], f.data[i+1:]...)
-			return true
-		}
-	}
-	return false
+	return f.del(func(v *knownFormat) bool { return v.Type == tpe })
 }
 
 // ContainsName returns true if this registry contains the specified name
 func (f *defaultFormats) ContainsName(name string) bool {
-	f.Lock()
-	defer f.Unlock()
-	nme := f.normalizeName(name)
-	for _, v := range f.data {
-		if v.Name == nme {
-			return true
-		}
+	_, ok := f.find(f.normalizeName(name))
+	return ok
+}
+
+// Describe returns the metadata of the format with the specified name
+func (f *defaultFormats) Describe(name string) (FormatInfo, bool) {
+	if v, ok := f.find(f.normalizeName(name)); ok {
+		return v.Info.clone(), true
 	}
-	return false
+	return FormatInfo{}, false
+}
+
+// List returns the metadata of all the formats in this registry, in registration order
+func (f *defaultFormats) List() []FormatInfo {
+	all := f.all()
+	infos := make([]FormatInfo, 0, len(all))
+	for _, v := range all {
+		infos = append(infos, v.Info.clone())
+	}
+	return infos
 }
 
 // ContainsFormat returns true if this registry contains the specified format
 func (f *defaultFormats) ContainsFormat(strfmt Format) bool {
-	f.Lock()
-	defer f.Unlock()
 	tpe := reflect.TypeOf(strfmt)
 	if tpe.Kind() == reflect.Ptr {
 		tpe = tpe.Elem()
 	}
-
-	for _, v := range f.data {
-		if v.Type == tpe {
-			return true
-		}
-	}
-	return false
+	_, ok := f.findType(tpe)
+	return ok
 }
 
 // Validates passed data against format.
@@ -273,35 +584,91 @@ func (f *defaultFormats) ContainsFormat(strfmt Format) bool {
 // Note that the format name is automatically normalized, e.g. one may
 // use "date-time" to use the "datetime" format validator.
 func (f *defaultFormats) Validates(name, data string) bool {
-	f.Lock()
-	defer f.Unlock()
-	nme := f.normalizeName(name)
-	for _, v := range f.data {
-		if v.Name == nme {
-			return v.Validator(data)
-		}
+	if v, ok := f.find(f.normalizeName(name)); ok {
+		return v.Validator(data)
 	}
 	return false
 }
 
+// ValidateE validates passed data against format and returns an error telling
+// why the data is invalid.
+//
+// When the format validator reports a *FormatError, its Name is set to the name
+// passed to ValidateE. Unknown formats yield an invalid type name error.
+func (f *defaultFormats) ValidateE(name, data string) error {
+	if v, ok := f.find(f.normalizeName(name)); ok {
+		return v.validate(name, data)
+	}
+	return errors.InvalidTypeName(name)
+}
+
 // Parse a string into the appropriate format representation type.
 //
 // E.g. parsing a string a "date" will return a Date type.
 func (f *defaultFormats) Parse(name, data string) (interface{}, error) {
+	if v, ok := f.find(f.normalizeName(name)); ok {
+		return v.decode(name, data)
+	}
+	return nil, errors.InvalidTypeName(name)
+}
+
+// Canonicalize makes the registry rewrite the values of the format with the
+// specified name in their canonical representation when it parses them, with
+// Parse or the mapstructure hooks, e.g. UUIDs are written in lower case with dashes.
+//
+// It returns false when the format is unknown or has a single representation
+// for each value. A format inherited from a parent registry is overridden by a
+// canonicalized copy, the parent is left untouched.
+func (f *defaultFormats) Canonicalize(name string) bool {
+	return f.setOption(name, func(v interface{}) bool {
+		_, ok := v.(canonicalizer)
+		return ok
+	}, func(v *knownFormat) {
+		v.Canonical = true
+	})
+}
+
+// UseDateTimeParser makes the registry parse the values of the date-time format
+// with the specified name with p, with Parse or the mapstructure hooks, e.g. to
+// only accept RFC 3339 date-times or to convert them to a location.
+//
+// It returns false when the format is unknown or its type is not one of the
+// date-time types of this package. A format inherited from a parent registry is
+// overridden by a copy, the parent is left untouched.
+func (f *defaultFormats) UseDateTimeParser(name string, p DateTimeParser) bool {
+	return f.setOption(name, func(v interface{}) bool {
+		_, ok := v.(dateTimeDecoder)
+		return ok
+	}, func(v *knownFormat) {
+		v.Parser = &p
+	})
+}
+
+// setOption sets an option of the format with the specified name when a value of
+// its type is supported, overriding the format of the parent registry by a copy
+func (f *defaultFormats) setOption(name string, supported func(interface{}) bool, set func(*knownFormat)) bool {
 	f.Lock()
 	defer f.Unlock()
+	if f.frozen {
+		return false
+	}
+
 	nme := f.normalizeName(name)
-	for _, v := range f.data {
-		if v.Name == nme {
-			nw := reflect.New(v.Type).Interface()
-			if dec, ok := nw.(encoding.TextUnmarshaler); ok {
-				if err := dec.UnmarshalText([]byte(data)); err != nil {
-					return nil, err
-				}
-				return nw, nil
-			}
-			return nil, errors.InvalidTypeName(name)
+	v, ok := f.find(nme)
+	if !ok || !supported(reflect.New(v.Type).Interface()) {
+		return false
+	}
+
+	s := f.load()
+	data := append([]knownFormat(nil), s.data...)
+	if _, local := s.byName[nme]; !local {
+		data = append(data, *v)
+	}
+	for i := range data {
+		if data[i].Name == nme {
+			set(&data[i])
 		}
 	}
-	return nil, errors.InvalidTypeName(name)
+	f.snapshot.Store(newFormatsSnapshot(data, s.hidden))
+	return true
 }
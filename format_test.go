@@ -15,6 +15,7 @@
 package strfmt
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -92,7 +93,7 @@ func init() {
 func TestFormatRegistry(t *testing.T) {
 	f2 := tf2("")
 	f3 := bf("")
-	registry := NewFormats()
+	registry := NewFormats().(*defaultFormats)
 
 	assert.True(t, registry.ContainsName("test-format"))
 	assert.True(t, registry.ContainsName("testformat"))
@@ -127,6 +128,48 @@ func TestFormatRegistry(t *testing.T) {
 	assert.False(t, registry.Validates("unknown", ""))
 }
 
+func TestFormatRegistry_ValidateE(t *testing.T) {
+	f2 := tf2("")
+	registry := NewFormats().(*defaultFormats)
+
+	assert.NoError(t, registry.ValidateE("test-format", "tfa"))
+	err := registry.ValidateE("test-format", "ffa")
+	if assert.IsType(t, &FormatError{}, err) {
+		assert.Equal(t, "test-format", err.(*FormatError).Name)
+		assert.Equal(t, ReasonInvalid, err.(*FormatError).Reason)
+	}
+
+	assert.True(t, registry.AddE("tf2", &f2, func(s string) error {
+		if !istf2(s) {
+			return &FormatError{Value: s, Offset: 0, Reason: ReasonInvalidCharacter}
+		}
+		return nil
+	}))
+	assert.True(t, registry.Validates("tf2", "afa"))
+	assert.False(t, registry.Validates("tf2", "bfa"))
+	assert.NoError(t, registry.ValidateE("tf2", "afa"))
+	err = registry.ValidateE("tf2", "bfa")
+	if assert.IsType(t, &FormatError{}, err) {
+		assert.Equal(t, "tf2", err.(*FormatError).Name)
+		assert.Equal(t, ReasonInvalidCharacter, err.(*FormatError).Reason)
+	}
+
+	assert.False(t, registry.Add("tf2", &f2, isbf))
+	assert.NoError(t, registry.ValidateE("tf2", "bfa"))
+
+	err = registry.ValidateE("uuid-4", "025b0d74-00a2-5048-bf57-227c5111bb34")
+	if assert.IsType(t, &FormatError{}, err) {
+		fe := err.(*FormatError)
+		assert.Equal(t, "uuid-4", fe.Name)
+		assert.Equal(t, 14, fe.Offset)
+		assert.Equal(t, ReasonInvalidVersion, fe.Reason)
+	}
+
+	assert.Error(t, registry.ValidateE("unknown", ""))
+	_, isFormatError := registry.ValidateE("unknown", "").(*FormatError)
+	assert.False(t, isFormatError)
+}
+
 type testStruct struct {
 	D          Date       `json:"d,omitempty"`
 	DT         DateTime   `json:"dt,omitempty"`
@@ -150,10 +193,11 @@ type testStruct struct {
 	Rgbcolor   RGBColor   `json:"rgbcolor,omitempty"`
 	B64        Base64     `json:"b64,omitempty"`
 	Pw         Password   `json:"pw,omitempty"`
+	Oid        ObjectId   `json:"oid,omitempty"`
 }
 
 func TestDecodeHook(t *testing.T) {
-	registry := NewFormats()
+	registry := NewFormats().(*defaultFormats)
 	m := map[string]interface{}{
 		"d":          "2014-12-15",
 		"dt":         "2012-03-02T15:06:05.999999999Z",
@@ -177,6 +221,7 @@ func TestDecodeHook(t *testing.T) {
 		"ssn":        "111-11-1111",
 		"creditcard": "4111-1111-1111-1111",
 		"b64":        "ZWxpemFiZXRocG9zZXk=",
+		"oid":        "507f1f77bcf86cd799439011",
 	}
 
 	date, _ := time.Parse(RFC3339FullDate, "2014-12-15")
@@ -204,8 +249,9 @@ func TestDecodeHook(t *testing.T) {
 		Ssn:        SSN("111-11-1111"),
 		Hexcolor:   HexColor("#FFFFFF"),
 		Rgbcolor:   RGBColor("rgb(255,255,255)"),
-		B64:        Base64("ZWxpemFiZXRocG9zZXk="),
+		B64:        Base64("elizabethposey"), // decoded by UnmarshalText
 		Pw:         Password("super secret stuff here"),
+		Oid:        NewObjectId("507f1f77bcf86cd799439011"),
 	}
 
 	test := new(testStruct)
@@ -221,3 +267,436 @@ func TestDecodeHook(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, exp, test)
 }
+
+func TestDecodeHook_base64(t *testing.T) {
+	registry := NewFormats().(*defaultFormats)
+	// the standard alphabet, with the + and / characters
+	m := map[string]interface{}{"b64": "+/8="}
+	assert.True(t, registry.Validates("byte", "+/8="))
+
+	for _, hook := range []mapstructure.DecodeHookFunc{registry.MapStructureHookFunc(), registry.ValidatingMapStructureHookFunc()} {
+		test := new(testStruct)
+		assert.NoError(t, decodeWith(hook, m, test))
+		assert.Equal(t, Base64{0xfb, 0xff}, test.B64)
+	}
+
+	v, err := registry.Parse("byte", "+/8=")
+	assert.NoError(t, err)
+	assert.Equal(t, Base64{0xfb, 0xff}, *v.(*Base64))
+
+	// the URL alphabet of MarshalText is decoded too
+	test := new(testStruct)
+	assert.NoError(t, decodeWith(registry.MapStructureHookFunc(), map[string]interface{}{"b64": "-_8="}, test))
+	assert.Equal(t, Base64{0xfb, 0xff}, test.B64)
+}
+
+type testPtrStruct struct {
+	Tf    *testFormat `json:"tf,omitempty"`
+	Uuid  *UUID       `json:"uuid,omitempty"`
+	DT    *DateTime   `json:"dt,omitempty"`
+	Other string      `json:"other,omitempty"`
+}
+
+func decodeWith(hook mapstructure.DecodeHookFunc, m map[string]interface{}, result interface{}) error {
+	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: hook,
+		Result:     result,
+	})
+	if err != nil {
+		return err
+	}
+	return d.Decode(m)
+}
+
+func TestDecodeHook_customAndPointers(t *testing.T) {
+	registry := NewFormats().(*defaultFormats)
+	m := map[string]interface{}{
+		"tf":    "tfa",
+		"uuid":  "a8098c1a-f86e-11da-bd1a-00112444be1e",
+		"dt":    "2012-03-02T15:06:05.999999999Z",
+		"other": "untouched",
+	}
+	dt, _ := ParseDateTime("2012-03-02T15:06:05.999999999Z")
+
+	test := new(testPtrStruct)
+	assert.NoError(t, decodeWith(registry.MapStructureHookFunc(), m, test))
+	if assert.NotNil(t, test.Tf) && assert.NotNil(t, test.Uuid) && assert.NotNil(t, test.DT) {
+		assert.Equal(t, testFormat("tfa"), *test.Tf)
+		assert.Equal(t, UUID("a8098c1a-f86e-11da-bd1a-00112444be1e"), *test.Uuid)
+		assert.Equal(t, dt, *test.DT)
+	}
+	assert.Equal(t, "untouched", test.Other)
+
+	// decoding errors are reported
+	m = map[string]interface{}{"dt": "yada"}
+	assert.Error(t, decodeWith(registry.MapStructureHookFunc(), m, new(testPtrStruct)))
+	m = map[string]interface{}{"oid": "yada"}
+	assert.Error(t, decodeWith(registry.MapStructureHookFunc(), m, new(testStruct)))
+}
+
+func TestDecodeHook_validating(t *testing.T) {
+	registry := NewFormats().(*defaultFormats)
+
+	m := map[string]interface{}{"tf": "tfa", "uuid": "a8098c1a-f86e-11da-bd1a-00112444be1e"}
+	test := new(testPtrStruct)
+	assert.NoError(t, decodeWith(registry.ValidatingMapStructureHookFunc(), m, test))
+	if assert.NotNil(t, test.Tf) {
+		assert.Equal(t, testFormat("tfa"), *test.Tf)
+	}
+
+	// not validated by default
+	m = map[string]interface{}{"tf": "ffa", "uuid": "not-a-uuid"}
+	test = new(testPtrStruct)
+	assert.NoError(t, decodeWith(registry.MapStructureHookFunc(), m, test))
+	if assert.NotNil(t, test.Uuid) {
+		assert.Equal(t, UUID("not-a-uuid"), *test.Uuid)
+	}
+
+	m = map[string]interface{}{"uuid": "not-a-uuid"}
+	err := decodeWith(registry.ValidatingMapStructureHookFunc(), m, new(testPtrStruct))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a valid uuid")
+
+	m = map[string]interface{}{"tf": "ffa"}
+	err = decodeWith(registry.ValidatingMapStructureHookFunc(), m, new(testPtrStruct))
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a valid test-format")
+}
+
+func TestFormatRegistry_concurrentAccess(t *testing.T) {
+	registry := NewFormats().(*defaultFormats)
+	f2 := tf2("")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			registry.Add("tf2", &f2, istf2)
+			registry.DelByName("tf2")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		assert.True(t, registry.Validates("uuid", "a8098c1a-f86e-11da-bd1a-00112444be1e"))
+		assert.True(t, registry.ContainsName("date-time"))
+		registry.Validates("tf2", "afa")
+	}
+	<-done
+	assert.False(t, registry.ContainsName("tf2"))
+}
+
+func BenchmarkRegistry_Validates(b *testing.B) {
+	registry := NewFormats().(*defaultFormats)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			registry.Validates("uuid4", "025b0d74-00a2-4048-bf57-227c5111bb34")
+		}
+	})
+}
+
+func BenchmarkRegistry_GetType(b *testing.B) {
+	registry := NewFormats().(*defaultFormats)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			registry.GetType("password")
+		}
+	})
+}
+
+func BenchmarkRegistry_ContainsName(b *testing.B) {
+	registry := NewFormats().(*defaultFormats)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			registry.ContainsName("date-time")
+		}
+	})
+}
+
+func BenchmarkRegistry_Parse(b *testing.B) {
+	registry := NewFormats().(*defaultFormats)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			_, _ = registry.Parse("date", "2014-12-15")
+		}
+	})
+}
+
+func TestFormatRegistry_Describe(t *testing.T) {
+	f2 := tf2("")
+	registry := NewFormats().(*defaultFormats)
+
+	info, ok := registry.Describe("test-format")
+	assert.True(t, ok)
+	assert.Equal(t, FormatInfo{Name: "test-format"}, info)
+
+	_, ok = registry.Describe("unknown")
+	assert.False(t, ok)
+
+	examples := []string{"afa"}
+	assert.True(t, registry.AddWithInfo("tf-2", &f2, WrapValidator(istf2), FormatInfo{
+		Name:            "ignored",
+		Description:     "a test format",
+		Pattern:         "^af",
+		MaxLength:       12,
+		Examples:        examples,
+		InvalidExamples: []string{"bfa"},
+	}))
+	assert.True(t, registry.Validates("tf2", "afa"))
+	assert.False(t, registry.Validates("tf2", "bfa"))
+
+	info, ok = registry.Describe("tf2")
+	assert.True(t, ok)
+	assert.Equal(t, FormatInfo{
+		Name:            "tf-2",
+		Description:     "a test format",
+		Pattern:         "^af",
+		MaxLength:       12,
+		Examples:        []string{"afa"},
+		InvalidExamples: []string{"bfa"},
+	}, info)
+
+	// the registered info can't be altered
+	examples[0] = "changed"
+	info.Examples[0] = "changed"
+	info, _ = registry.Describe("tf2")
+	assert.Equal(t, []string{"afa"}, info.Examples)
+
+	// replacing a format replaces its info
+	assert.False(t, registry.Add("tf2", &f2, istf2))
+	info, _ = registry.Describe("tf2")
+	assert.Equal(t, FormatInfo{Name: "tf2"}, info)
+
+	infos := registry.List()
+	assert.Len(t, infos, len(Default.(*defaultFormats).List())+1)
+	assert.Equal(t, "tf2", infos[len(infos)-1].Name)
+}
+
+// plainRegistry hides the optional interfaces of the registry it wraps
+type plainRegistry struct {
+	Registry
+}
+
+func TestAddWithInfo(t *testing.T) {
+	var _ RegistryE = Default.(*defaultFormats)
+	var _ DescribingRegistry = Default.(*defaultFormats)
+	var _ FreezableRegistry = Default.(*defaultFormats)
+	var _ ConfigurableRegistry = Default.(*defaultFormats)
+
+	f2 := tf2("")
+	registry := NewSeededFormats(nil, nil)
+	assert.True(t, AddWithInfo(registry, "tf2", &f2, WrapValidator(istf2), FormatInfo{Description: "a test format"}))
+	info, ok := registry.(DescribingRegistry).Describe("tf2")
+	assert.True(t, ok)
+	assert.Equal(t, "a test format", info.Description)
+
+	// the metadata are left out by other registries
+	plain := plainRegistry{NewSeededFormats(nil, nil)}
+	assert.True(t, AddWithInfo(plain, "tf2", &f2, WrapValidator(istf2), FormatInfo{Description: "a test format"}))
+	assert.False(t, AddWithInfo(plain, "tf2", &f2, WrapValidator(istf2), FormatInfo{}))
+	assert.True(t, plain.Validates("tf2", "afa"))
+	assert.False(t, plain.Validates("tf2", "ffa"))
+
+	_, err := ExportSchemas(plain, OpenAPI2)
+	assert.Error(t, err)
+}
+
+func TestDefaultFormats_info(t *testing.T) {
+	for _, info := range Default.(*defaultFormats).List() {
+		if info.Name == "test-format" {
+			continue
+		}
+		assert.NotEmpty(t, info.Description, "format %s has no description", info.Name)
+		assert.NotEmpty(t, info.Examples, "format %s has no example", info.Name)
+
+		var rx *regexp.Regexp
+		if info.Pattern != "" {
+			rx = regexp.MustCompile(info.Pattern)
+		}
+		for _, example := range info.Examples {
+			assert.True(t, Default.Validates(info.Name, example), "example %q of %s is not valid", example, info.Name)
+			if rx != nil {
+				assert.True(t, rx.MatchString(example), "example %q of %s does not match its pattern", example, info.Name)
+			}
+			if info.MaxLength > 0 {
+				assert.True(t, len(example) <= info.MaxLength, "example %q of %s is too long", example, info.Name)
+			}
+		}
+		for _, example := range info.InvalidExamples {
+			assert.False(t, Default.Validates(info.Name, example), "invalid example %q of %s is valid", example, info.Name)
+		}
+	}
+}
+
+func TestNewLayeredFormats(t *testing.T) {
+	f2 := tf2("")
+	f3 := bf("")
+	parent := NewSeededFormats(nil, nil).(*defaultFormats)
+	assert.True(t, parent.Add("tf2", &f2, istf2))
+	child := NewLayeredFormats(parent).(*defaultFormats)
+
+	// formats are inherited
+	assert.True(t, child.ContainsName("tf2"))
+	assert.True(t, child.Validates("tf2", "afa"))
+	tpe, ok := child.GetType("tf2")
+	assert.True(t, ok)
+	assert.Equal(t, "tf2", tpe.Name())
+
+	// formats added to the parent later on are seen by the child
+	assert.True(t, parent.Add("tf3", &f3, istf3))
+	assert.True(t, child.Validates("tf3", "ffa"))
+	assert.Equal(t, []string{"tf2", "tf3"}, infoNames(child.List()))
+
+	// overriding a format doesn't affect the parent
+	assert.False(t, child.Add("tf2", &f3, isbf))
+	assert.True(t, child.Validates("tf2", "bfa"))
+	assert.False(t, parent.Validates("tf2", "bfa"))
+	assert.Equal(t, []string{"tf2", "tf3"}, infoNames(child.List()))
+
+	// neither does adding one
+	assert.True(t, child.Add("tf4", &f2, istf2))
+	assert.False(t, parent.ContainsName("tf4"))
+	assert.Equal(t, []string{"tf2", "tf3", "tf4"}, infoNames(child.List()))
+
+	// deleting an inherited format hides it from the child only
+	assert.True(t, child.DelByName("tf3"))
+	assert.False(t, child.ContainsName("tf3"))
+	assert.True(t, parent.ContainsName("tf3"))
+	assert.False(t, child.DelByName("tf3"))
+	assert.Equal(t, []string{"tf2", "tf4"}, infoNames(child.List()))
+	assert.True(t, child.Add("tf3", &f3, istf3))
+	assert.True(t, child.ContainsName("tf3"))
+
+	// deleting an override deletes the format for the child
+	assert.True(t, child.DelByName("tf2"))
+	assert.False(t, child.ContainsName("tf2"))
+	assert.True(t, parent.ContainsName("tf2"))
+
+	// grand children fall back to all their ancestors
+	grandChild := NewLayeredFormats(child)
+	assert.True(t, grandChild.ContainsName("tf3"))
+	assert.True(t, grandChild.ContainsName("tf4"))
+	assert.False(t, grandChild.ContainsName("tf2"))
+}
+
+func TestNewLayeredFormats_decodeHook(t *testing.T) {
+	f2 := tf2("")
+	f3 := bf("")
+	parent := NewSeededFormats(nil, nil)
+	parent.Add("tf2", &f2, istf2)
+	child := NewLayeredFormats(parent)
+
+	type withTF2 struct {
+		TF tf2 `json:"tf"`
+	}
+	m := map[string]interface{}{"tf": "afa"}
+	var result withTF2
+	assert.NoError(t, decodeWith(child.MapStructureHookFunc(), m, &result))
+	assert.Equal(t, tf2("afa"), result.TF)
+
+	// the type of an overridden format is no longer known to the child
+	child.Add("tf2", &f3, isbf)
+	assert.False(t, child.(*defaultFormats).ContainsFormat(&f2))
+	assert.True(t, child.(*defaultFormats).ContainsFormat(&f3))
+	assert.True(t, parent.(*defaultFormats).ContainsFormat(&f2))
+}
+
+func TestNewFormats_live(t *testing.T) {
+	registry := NewFormats().(*defaultFormats)
+	assert.Equal(t, infoNames(Default.(*defaultFormats).List()), infoNames(registry.List()))
+
+	// deleting a default format doesn't affect Default
+	assert.True(t, registry.DelByName("date-time"))
+	assert.False(t, registry.ContainsName("date-time"))
+	assert.True(t, Default.ContainsName("date-time"))
+}
+
+func TestFormatRegistry_Clone(t *testing.T) {
+	f2 := tf2("")
+	f3 := bf("")
+	parent := NewSeededFormats(nil, nil).(*defaultFormats)
+	parent.Add("tf2", &f2, istf2)
+	child := NewLayeredFormats(parent).(*defaultFormats)
+	child.Add("tf3", &f3, istf3)
+
+	clone := child.Clone().(*defaultFormats)
+	assert.Equal(t, []string{"tf2", "tf3"}, infoNames(clone.List()))
+	assert.True(t, clone.Validates("tf2", "afa"))
+
+	// the clone evolves independently
+	parent.Add("tf4", &f2, istf2)
+	assert.True(t, child.ContainsName("tf4"))
+	assert.False(t, clone.ContainsName("tf4"))
+	assert.True(t, clone.DelByName("tf2"))
+	assert.True(t, child.ContainsName("tf2"))
+}
+
+func TestFormatRegistry_Freeze(t *testing.T) {
+	f2 := tf2("")
+	registry := NewSeededFormats(nil, nil).(*defaultFormats)
+	registry.Add("tf2", &f2, istf2)
+	registry.Freeze()
+
+	assert.False(t, registry.Add("tf3", &f2, istf3))
+	assert.False(t, registry.AddE("tf2", &f2, WrapValidator(istf3)))
+	assert.False(t, registry.AddWithInfo("tf3", &f2, WrapValidator(istf3), FormatInfo{}))
+	assert.False(t, registry.DelByName("tf2"))
+	assert.False(t, registry.ContainsName("tf3"))
+	assert.True(t, registry.Validates("tf2", "afa"))
+	assert.False(t, registry.Validates("tf2", "ffa"))
+
+	// layered registries and clones may be modified
+	child := NewLayeredFormats(registry).(*defaultFormats)
+	assert.True(t, child.DelByName("tf2"))
+	assert.True(t, registry.ContainsName("tf2"))
+	clone := registry.Clone()
+	assert.True(t, clone.Add("tf3", &f2, istf3))
+}
+
+func TestFormatRegistry_Canonicalize(t *testing.T) {
+	registry := NewFormats().(*defaultFormats)
+	assert.True(t, registry.Canonicalize("uuid"))
+	assert.False(t, registry.Canonicalize("date"), "a date has a single representation")
+	assert.False(t, registry.Canonicalize("unknown"))
+
+	v, err := registry.Parse("uuid", "A0EEBC999C0B4EF8BB6D6BB9BD380A11")
+	assert.NoError(t, err)
+	assert.Equal(t, UUID("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"), *v.(*UUID))
+
+	var m struct {
+		ID  UUID
+		Ref *UUID
+	}
+	err = decodeWith(registry.MapStructureHookFunc(), map[string]interface{}{
+		"id":  "A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11",
+		"ref": "a0eebc999c0b4ef8bb6d6bb9bd380a11",
+	}, &m)
+	assert.NoError(t, err)
+	assert.Equal(t, UUID("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"), m.ID)
+	assert.Equal(t, UUID("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"), *m.Ref)
+
+	// the parent is left untouched
+	v, err = Default.Parse("uuid", "A0EEBC999C0B4EF8BB6D6BB9BD380A11")
+	assert.NoError(t, err)
+	assert.Equal(t, UUID("A0EEBC999C0B4EF8BB6D6BB9BD380A11"), *v.(*UUID))
+
+	// replacing the format resets it
+	u := UUID("")
+	registry.AddE("uuid", &u, ValidateUUID)
+	v, err = registry.Parse("uuid", "A0EEBC999C0B4EF8BB6D6BB9BD380A11")
+	assert.NoError(t, err)
+	assert.Equal(t, UUID("A0EEBC999C0B4EF8BB6D6BB9BD380A11"), *v.(*UUID))
+
+	registry.Freeze()
+	assert.False(t, registry.Canonicalize("uuid4"))
+}
+
+func infoNames(infos []FormatInfo) []string {
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name)
+	}
+	return names
+}
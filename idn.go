@@ -0,0 +1,261 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"net"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+)
+
+// IsIDNHostname returns true when the string is a valid internationalized hostname
+func IsIDNHostname(str string) bool {
+	return ValidateIDNHostname(str) == nil
+}
+
+// ValidateIDNHostname returns a *FormatError when the string is not a valid
+// internationalized hostname, as defined by RFC 5890 section 2.3.2.3
+func ValidateIDNHostname(str string) error {
+	return validateIDNHostname("idn-hostname", str)
+}
+
+// idnaProfile checks labels for registration, their length is checked separately
+var idnaProfile = idna.New(idna.ValidateForRegistration(), idna.VerifyDNSLength(false))
+
+// isLabelSeparator reports whether r separates the labels of an internationalized
+// hostname, as defined by RFC 3490 section 3.1
+func isLabelSeparator(r rune) bool {
+	return r == '.' || r == '。' || r == '．' || r == '｡'
+}
+
+func validateIDNHostname(name, str string) error {
+	length := 0
+	start := 0
+	for i := 0; ; {
+		r, size := utf8.DecodeRuneInString(str[i:])
+		if i < len(str) && !isLabelSeparator(r) {
+			i += size
+			continue
+		}
+		if i == start {
+			// empty label
+			return newFormatError(name, str, i, ReasonInvalidSyntax)
+		}
+		n, err := validateIDNLabel(name, str, start, i)
+		if err != nil {
+			return err
+		}
+		// the length limit applies to the ASCII form of the hostname
+		length += n + 1
+		if length-1 > 255 {
+			return newFormatError(name, str, start, ReasonInvalidLength)
+		}
+		if i == len(str) {
+			return nil
+		}
+		i += size
+		start = i
+	}
+}
+
+// validateIDNLabel checks the label str[start:end] is valid for registration,
+// with the rules of the IDNA2008 protocol, and returns the length of its ASCII form
+func validateIDNLabel(name, str string, start, end int) (int, error) {
+	label := asciiToLower(str[start:end])
+	a, err := idnaProfile.ToASCII(label)
+	if err != nil {
+		return 0, newFormatError(name, str, start, ReasonInvalidCharacter)
+	}
+	if len(a) > 63 {
+		return 0, newFormatError(name, str, start, ReasonInvalidLength)
+	}
+
+	offset := start
+	if label == a && strings.HasPrefix(a, "xn--") {
+		// an A-label: the context rules are checked on its decoded form
+		if label, err = idnaProfile.ToUnicode(a); err != nil {
+			return 0, newFormatError(name, str, start, ReasonInvalidCharacter)
+		}
+		offset = -1
+	}
+	if j := checkIDNContext(label); j >= 0 {
+		if offset < 0 {
+			return 0, newFormatError(name, str, start, ReasonInvalidCharacter)
+		}
+		return 0, newFormatError(name, str, offset+j, ReasonInvalidCharacter)
+	}
+	return len(a), nil
+}
+
+// checkIDNContext returns the offset of the first rune of label which does not
+// satisfy the CONTEXTO rules of RFC 5892 appendix A or belongs to the
+// DISALLOWED exceptions of RFC 5892 section 2.6, or -1 when the label is valid.
+func checkIDNContext(label string) int {
+	var prev rune
+	var arabicIndic, extendedArabicIndic, japanese bool
+	for _, r := range label {
+		switch {
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana, unicode.Han) && r != '・':
+			japanese = true
+		case r >= '٠' && r <= '٩':
+			arabicIndic = true
+		case r >= '۰' && r <= '۹':
+			extendedArabicIndic = true
+		}
+	}
+
+	for i, r := range label {
+		var next rune
+		if rest := label[i+utf8.RuneLen(r):]; rest != "" {
+			next, _ = utf8.DecodeRuneInString(rest)
+		}
+		switch r {
+		case '·':
+			// MIDDLE DOT
+			if prev != 'l' || next != 'l' {
+				return i
+			}
+		case '͵':
+			// GREEK LOWER NUMERAL SIGN (KERAIA)
+			if !unicode.Is(unicode.Greek, next) {
+				return i
+			}
+		case '׳', '״':
+			// HEBREW PUNCTUATION GERESH and GERSHAYIM
+			if !unicode.Is(unicode.Hebrew, prev) {
+				return i
+			}
+		case '・':
+			// KATAKANA MIDDLE DOT
+			if !japanese {
+				return i
+			}
+		case 'ـ', 'ߺ', '〮', '〯', '〱', '〲', '〳', '〴', '〵', '〻':
+			return i
+		}
+		if r >= '٠' && r <= '٩' && extendedArabicIndic || r >= '۰' && r <= '۹' && arabicIndic {
+			// ARABIC-INDIC DIGITS can't be mixed with EXTENDED ARABIC-INDIC DIGITS
+			return i
+		}
+		prev = r
+	}
+	return -1
+}
+
+// asciiToLower lowercases the ASCII letters of str, since hostnames are case insensitive
+func asciiToLower(str string) string {
+	for i := 0; i < len(str); i++ {
+		if 'A' <= str[i] && str[i] <= 'Z' {
+			b := []byte(str)
+			for j := i; j < len(b); j++ {
+				if 'A' <= b[j] && b[j] <= 'Z' {
+					b[j] += 'a' - 'A'
+				}
+			}
+			return string(b)
+		}
+	}
+	return str
+}
+
+// IsIDNEmail returns true when the string is a valid internationalized email address
+func IsIDNEmail(str string) bool {
+	return ValidateIDNEmail(str) == nil
+}
+
+// ValidateIDNEmail returns a *FormatError when the string is not a valid
+// internationalized email address, as defined by RFC 6531
+func ValidateIDNEmail(str string) error {
+	const name = "idn-email"
+	at := strings.LastIndexByte(str, '@')
+	if at < 0 {
+		return newFormatError(name, str, len(str), ReasonInvalidSyntax)
+	}
+	if at > 64 {
+		return newFormatError(name, str, 64, ReasonInvalidLength)
+	}
+	if bad := checkEmailLocalPart(str[:at]); bad >= 0 {
+		return newFormatError(name, str, bad, ReasonInvalidCharacter)
+	}
+
+	domain := str[at+1:]
+	if strings.HasPrefix(domain, "[") && strings.HasSuffix(domain, "]") {
+		// address literal
+		addr := domain[1 : len(domain)-1]
+		if strings.HasPrefix(addr, "IPv6:") {
+			if ip := net.ParseIP(addr[5:]); ip != nil && strings.IndexByte(addr[5:], ':') >= 0 {
+				return nil
+			}
+		} else if ip := net.ParseIP(addr); ip != nil && ip.To4() != nil && strings.IndexByte(addr, ':') < 0 {
+			return nil
+		}
+		return newFormatError(name, str, at+2, ReasonInvalidSyntax)
+	}
+	if err := validateIDNHostname(name, domain); err != nil {
+		// offsets in the domain are offsets in str after the @
+		fe := err.(*FormatError)
+		fe.Value = str
+		fe.Offset += at + 1
+		return fe
+	}
+	return nil
+}
+
+// checkEmailLocalPart returns the offset of the first invalid character of the
+// local part of an email address, or -1 when it is valid:
+// either a dot-atom or a quoted string, where UTF-8 characters are allowed
+func checkEmailLocalPart(local string) int {
+	if local == "" {
+		return 0
+	}
+	if len(local) > 1 && local[0] == '"' && local[len(local)-1] == '"' {
+		for i := 1; i < len(local)-1; i++ {
+			switch c := local[i]; {
+			case c == '\\':
+				// quoted-pair
+				i++
+				if i == len(local)-1 || local[i] < ' ' || local[i] > '~' {
+					return i
+				}
+			case c == '"' || c < ' ' || c == 0x7f:
+				return i
+			}
+		}
+		return -1
+	}
+
+	for i := 0; i < len(local); i++ {
+		c := local[i]
+		switch {
+		case c == '.':
+			if i == 0 || i == len(local)-1 || local[i-1] == '.' {
+				return i
+			}
+		case c >= utf8.RuneSelf:
+			r, size := utf8.DecodeRuneInString(local[i:])
+			if r == utf8.RuneError && size == 1 {
+				return i
+			}
+			i += size - 1
+		case isLetter(c) || isDigit(c) || strings.IndexByte("!#$%&'*+-/=?^_`{|}~", c) >= 0:
+		default:
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,84 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIDNHostname(t *testing.T) {
+	valid := []string{
+		"실례.테스트",
+		"xn--ihqwcrb4cv8a8dqg056pqjye",
+		"hostname",
+		"Example.COM",
+		"a。b．c｡d",
+		"l·l",
+		"α͵β",
+		"א׳ב",
+		"・ぁ",
+		"ب٠ب",
+		"क्‍ष",
+	}
+	for _, str := range valid {
+		assert.NoError(t, ValidateIDNHostname(str), str)
+		assert.True(t, IsIDNHostname(str), str)
+	}
+
+	assertFormatError(t, ValidateIDNHostname(""), "idn-hostname", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateIDNHostname("a..b"), "idn-hostname", 2, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateIDNHostname("a."), "idn-hostname", 2, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateIDNHostname("a.-hello"), "idn-hostname", 2, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNHostname("a.〮실례"), "idn-hostname", 2, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNHostname("a."+strings.Repeat("b", 64)), "idn-hostname", 2, ReasonInvalidLength)
+	assertFormatError(t, ValidateIDNHostname(strings.Repeat("a.", 128)+"a"), "idn-hostname", 256, ReasonInvalidLength)
+	assertFormatError(t, ValidateIDNHostname("a·l"), "idn-hostname", 1, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNHostname("x.α͵"), "idn-hostname", 4, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNHostname("׳ב"), "idn-hostname", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNHostname("def・abc"), "idn-hostname", 3, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNHostname("ب٠۰"), "idn-hostname", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNHostname("〱〲"), "idn-hostname", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNHostname("‍ष"), "idn-hostname", 0, ReasonInvalidCharacter)
+}
+
+func TestValidateIDNEmail(t *testing.T) {
+	valid := []string{
+		"실례@실례.테스트",
+		"joe.bloggs@example.com",
+		"\"joe bloggs\"@example.com",
+		"\"joe\\\"bloggs\"@example.com",
+		"joe@[127.0.0.1]",
+		"joe@[IPv6:::1]",
+		"ñoño+tag@ejemplo.es",
+	}
+	for _, str := range valid {
+		assert.NoError(t, ValidateIDNEmail(str), str)
+		assert.True(t, IsIDNEmail(str), str)
+	}
+
+	assertFormatError(t, ValidateIDNEmail("2962"), "idn-email", 4, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateIDNEmail("@example.com"), "idn-email", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNEmail(".joe@example.com"), "idn-email", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNEmail("joe..bloggs@example.com"), "idn-email", 4, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNEmail("joe bloggs@example.com"), "idn-email", 3, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNEmail("\"joe\"bloggs\"@example.com"), "idn-email", 4, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIDNEmail(strings.Repeat("a", 65)+"@example.com"), "idn-email", 64, ReasonInvalidLength)
+	assertFormatError(t, ValidateIDNEmail("joe@example..com"), "idn-email", 12, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateIDNEmail("joe@[300.0.0.1]"), "idn-email", 5, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateIDNEmail("joe@[::1]"), "idn-email", 5, ReasonInvalidSyntax)
+}
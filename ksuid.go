@@ -0,0 +1,97 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"bytes"
+	"strings"
+	"time"
+)
+
+const (
+	// base62Alphabet are the digits of the base62 encoding of KSUIDs
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	// ksuidEpoch is the Unix time in seconds of the epoch of KSUIDs, in May 2014
+	ksuidEpoch = 1400000000
+)
+
+// base62Digit returns the value of a base62 digit, -1 for other characters
+func base62Digit(c byte) int {
+	return strings.IndexByte(base62Alphabet, c)
+}
+
+// IsKSUID returns true when the string is a valid KSUID
+func IsKSUID(str string) bool {
+	return ValidateKSUID(str) == nil
+}
+
+// ValidateKSUID returns a *FormatError when the string is not a KSUID: 27 digits
+// of the base62 encoding, up to aWgEPTl1tmebfsQzFP4bxwgy80V
+func ValidateKSUID(str string) error {
+	_, err := decodeBase("ksuid", str, 62, 27, 20, base62Digit)
+	return err
+}
+
+var ksuids = &monotonicGenerator{now: time.Now, unit: time.Second}
+
+// NewKSUID returns a new KSUID, made of the current time in seconds and of a
+// random payload of 128 bits. It panics when the random generator of the system fails.
+//
+// The KSUIDs generated by a process are strictly increasing: the payload is
+// incremented for the KSUIDs generated within the same second.
+func NewKSUID() KSUID {
+	return newKSUID(ksuids)
+}
+
+func newKSUID(g *monotonicGenerator) KSUID {
+	sec, payload := g.next(16)
+	ts := sec - ksuidEpoch
+	b := append([]byte{byte(ts >> 24), byte(ts >> 16), byte(ts >> 8), byte(ts)}, payload...)
+	return KSUID(encodeBase(b, base62Alphabet, 27))
+}
+
+// Bytes returns the 20 bytes of the KSUID, all zero when it isn't a valid KSUID
+func (k KSUID) Bytes() [20]byte {
+	var b [20]byte
+	if d, err := decodeBase("ksuid", string(k), 62, 27, 20, base62Digit); err == nil {
+		copy(b[:], d)
+	}
+	return b
+}
+
+// Payload returns the 16 random bytes of the KSUID, all zero when it isn't a valid KSUID
+func (k KSUID) Payload() [16]byte {
+	var p [16]byte
+	b := k.Bytes()
+	copy(p[:], b[4:])
+	return p
+}
+
+// Time returns the time the KSUID was generated at, to the second in UTC, the
+// zero time when it isn't a valid KSUID
+func (k KSUID) Time() time.Time {
+	if ValidateKSUID(string(k)) != nil {
+		return time.Time{}
+	}
+	b := k.Bytes()
+	ts := int64(b[0])<<24 | int64(b[1])<<16 | int64(b[2])<<8 | int64(b[3])
+	return time.Unix(ts+ksuidEpoch, 0).UTC()
+}
+
+// Compare returns -1 if k sorts before o, +1 if k sorts after o and 0 if they are the same KSUID
+func (k KSUID) Compare(o KSUID) int {
+	kb, ob := k.Bytes(), o.Bytes()
+	return bytes.Compare(kb[:], ob[:])
+}
@@ -0,0 +1,74 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateKSUID_errors(t *testing.T) {
+	assertFormatError(t, ValidateKSUID(""), "ksuid", 0, ReasonInvalidLength)
+	assertFormatError(t, ValidateKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLO"), "ksuid", 26, ReasonInvalidLength)
+	assertFormatError(t, ValidateKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOvv"), "ksuid", 27, ReasonInvalidLength)
+	assertFormatError(t, ValidateKSUID("0ujtsYcgvSTl8PAu_dqWYSMnLOv"), "ksuid", 16, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateKSUID("aWgEPTl1tmebfsQzFP4bxwgy80W"), "ksuid", 0, ReasonOutOfRange)
+	assertFormatError(t, ValidateKSUID("zzzzzzzzzzzzzzzzzzzzzzzzzzz"), "ksuid", 0, ReasonOutOfRange)
+}
+
+func TestKSUID(t *testing.T) {
+	k := KSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	assert.Equal(t, [20]byte{
+		0x06, 0x69, 0xf7, 0xef, 0xb5, 0xa1, 0xcd, 0x34, 0xb5, 0xf9,
+		0x9d, 0x11, 0x54, 0xfb, 0x68, 0x53, 0x34, 0x5c, 0x97, 0x35,
+	}, k.Bytes())
+	assert.Equal(t, [16]byte{
+		0xb5, 0xa1, 0xcd, 0x34, 0xb5, 0xf9, 0x9d, 0x11,
+		0x54, 0xfb, 0x68, 0x53, 0x34, 0x5c, 0x97, 0x35,
+	}, k.Payload())
+	assert.Equal(t, time.Date(2017, time.October, 10, 4, 0, 47, 0, time.UTC), k.Time())
+
+	assert.Equal(t, 0, k.Compare("0ujtsYcgvSTl8PAuAdqWYSMnLOv"))
+	assert.Equal(t, -1, k.Compare("0ujtsYcgvSTl8PAuAdqWYSMnLOw"))
+	assert.Equal(t, +1, k.Compare("0ujtsYcgvSTl8PAuAdqWYSMnLOV"), "upper case digits are smaller")
+
+	assert.Equal(t, [20]byte{}, KSUID("not a ksuid").Bytes())
+	assert.True(t, KSUID("not a ksuid").Time().IsZero())
+}
+
+func TestNewKSUID(t *testing.T) {
+	k := NewKSUID()
+	assert.NoError(t, ValidateKSUID(string(k)))
+	assert.WithinDuration(t, time.Now(), k.Time(), 2*time.Second)
+	assert.Equal(t, -1, k.Compare(NewKSUID()))
+}
+
+func TestKSUIDGenerator_monotonic(t *testing.T) {
+	now := time.Date(2017, time.October, 10, 4, 0, 47, 0, time.UTC)
+	g := &monotonicGenerator{now: func() time.Time { return now }, unit: time.Second}
+
+	ksuids := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		ksuids = append(ksuids, string(newKSUID(g)))
+	}
+	assert.True(t, sort.StringsAreSorted(ksuids))
+	for i := 1; i < len(ksuids); i++ {
+		assert.Equal(t, -1, KSUID(ksuids[i-1]).Compare(KSUID(ksuids[i])))
+		assert.Equal(t, now, KSUID(ksuids[i]).Time())
+	}
+}
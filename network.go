@@ -0,0 +1,765 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func init() {
+	ip := IP("")
+	// register these formats in the default registry
+	AddWithInfo(Default, "ip", &ip, ValidateIP, FormatInfo{
+		Description:     "An IPv4 address in dotted-quad notation or an IPv6 address, as defined by RFC 4291",
+		MaxLength:       45,
+		Examples:        []string{"192.168.254.1", "::1", "2001:db8::68", "::ffff:192.0.2.1"},
+		InvalidExamples: []string{"", "192.168.254.2.2", "2001:db8::g", "fe80::1%eth0"},
+	})
+	cidr := CIDR("")
+	AddWithInfo(Default, "cidr", &cidr, ValidateCIDR, FormatInfo{
+		Description:     "An IP address and a prefix length in CIDR notation, as defined by RFC 4632 and RFC 4291",
+		MaxLength:       49,
+		Examples:        []string{"10.0.0.0/8", "192.168.1.5/24", "2001:db8::/32", "::/0"},
+		InvalidExamples: []string{"10.0.0.0", "10.0.0.0/33", "2001:db8::/129", "10.0.0.0/-1"},
+	})
+	port := Port(0)
+	AddWithInfo(Default, "port", &port, ValidatePort, FormatInfo{
+		Description:     "A TCP or UDP port number, from 1 to 65535",
+		Pattern:         `^[0-9]{1,5}$`,
+		MaxLength:       5,
+		Examples:        []string{"80", "8080", "65535"},
+		InvalidExamples: []string{"0", "65536", "-1", "http"},
+	})
+	hp := HostPort("")
+	AddWithInfo(Default, "host-port", &hp, ValidateHostPort, FormatInfo{
+		Description:     "A hostname or an IP address and a port, IPv6 addresses being enclosed in brackets",
+		Examples:        []string{"example.com:443", "10.0.0.1:80", "[::1]:8080"},
+		InvalidExamples: []string{"example.com", ":8080", "::1:8080", "[10.0.0.1]:80", "example.com:0"},
+	})
+}
+
+// parseIP parses an IPv4 address in dotted-quad notation or an IPv6 address,
+// v6 tells which notation was used: an IPv4-mapped IPv6 address such as
+// ::ffff:192.0.2.1 is an IPv6 address
+func parseIP(name, str string) (ip net.IP, v6 bool, err error) {
+	if str == "" {
+		return nil, false, newFormatError(name, str, 0, ReasonInvalidLength)
+	}
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; !isHexDigit(c) && c != '.' && c != ':' {
+			return nil, false, newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+	}
+	if ip = net.ParseIP(str); ip == nil {
+		return nil, false, newFormatError(name, str, -1, ReasonInvalid)
+	}
+	return ip, strings.IndexByte(str, ':') >= 0, nil
+}
+
+// formatIP writes the canonical representation of an IP address: IPv6
+// addresses are written in lower case and compressed as defined by RFC 5952
+func formatIP(ip net.IP, v6 bool) string {
+	if v4 := ip.To4(); v6 && v4 != nil {
+		return "::ffff:" + v4.String()
+	}
+	return ip.String()
+}
+
+// IsIP returns true when the string is a valid IP address of either family
+func IsIP(str string) bool {
+	return ValidateIP(str) == nil
+}
+
+// ValidateIP returns a *FormatError when the string is neither an IPv4 address
+// in dotted-quad notation nor an IPv6 address
+func ValidateIP(str string) error {
+	_, _, err := parseIP("ip", str)
+	return err
+}
+
+// IP represents an IP address of either family, such as 192.168.1.1 or 2001:db8::68.
+//
+// It is written in its canonical representation, e.g. an IPv6 address in lower case.
+// The zero value is written as an empty string, or NULL in a database, and read back from it.
+//
+// swagger:strfmt ip
+type IP string
+
+// NetIP returns the IP address, nil when it isn't valid
+func (ip IP) NetIP() net.IP {
+	addr, _, err := parseIP("ip", string(ip))
+	if err != nil {
+		return nil
+	}
+	return addr
+}
+
+// Canonical returns the canonical representation of the IP address, unchanged when it isn't valid
+func (ip IP) Canonical() IP {
+	addr, v6, err := parseIP("ip", string(ip))
+	if err != nil {
+		return ip
+	}
+	return IP(formatIP(addr, v6))
+}
+
+func (ip *IP) canonicalize() {
+	*ip = ip.Canonical()
+}
+
+// String converts this IP address to a string
+func (ip IP) String() string {
+	return string(ip)
+}
+
+// MarshalText serializes this IP address in its canonical representation
+func (ip IP) MarshalText() ([]byte, error) {
+	return []byte(ip.Canonical()), nil
+}
+
+// UnmarshalText parses a text representation into an IP address, the empty text into the zero value
+func (ip *IP) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*ip = ""
+		return nil
+	}
+	if err := ValidateIP(string(data)); err != nil {
+		return err
+	}
+	*ip = IP(data)
+	return nil
+}
+
+// Scan scans an IP value from database driver type.
+func (ip *IP) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return ip.UnmarshalText(v)
+	case string:
+		return ip.UnmarshalText([]byte(v))
+	case nil:
+		*ip = IP("")
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.IP from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts IP to a primitive value ready to be written to a database, the zero value to NULL.
+func (ip IP) Value() (driver.Value, error) {
+	if ip == "" {
+		return nil, nil
+	}
+	return driver.Value(string(ip.Canonical())), nil
+}
+
+// MarshalJSON returns the IP as JSON
+func (ip IP) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	ip.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the IP to a easyjson.Writer
+func (ip IP) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(ip.Canonical()))
+}
+
+// UnmarshalJSON sets the IP from JSON
+func (ip *IP) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	ip.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the IP from a easyjson.Lexer
+func (ip *IP) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		if err := ip.UnmarshalText([]byte(data)); err != nil {
+			in.AddError(err)
+		}
+	}
+}
+
+// GetBSON returns the IP as a bson.M{} map.
+func (ip *IP) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(ip.Canonical())}, nil
+}
+
+// SetBSON sets the IP from raw bson data
+func (ip *IP) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return ip.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as IP")
+}
+
+// parseCIDR parses an IP address and a prefix length in CIDR notation
+func parseCIDR(str string) (ip net.IP, v6 bool, bits int, err error) {
+	const name = "cidr"
+	slash := strings.IndexByte(str, '/')
+	if slash < 0 {
+		return nil, false, 0, newFormatError(name, str, len(str), ReasonInvalidSyntax)
+	}
+	if ip, v6, err = parseIP(name, str[:slash]); err != nil {
+		return nil, false, 0, shiftFormatError(err, name, str, 0)
+	}
+
+	size := 8 * net.IPv4len
+	if v6 {
+		size = 8 * net.IPv6len
+	}
+	length := str[slash+1:]
+	if length == "" {
+		return nil, false, 0, newFormatError(name, str, slash+1, ReasonInvalidLength)
+	}
+	for i := 0; i < len(length); i++ {
+		if !isDigit(length[i]) {
+			return nil, false, 0, newFormatError(name, str, slash+1+i, ReasonInvalidCharacter)
+		}
+	}
+	if bits, err = strconv.Atoi(length); err != nil || bits > size {
+		return nil, false, 0, newFormatError(name, str, slash+1, ReasonOutOfRange)
+	}
+	return ip, v6, bits, nil
+}
+
+// IsCIDR returns true when the string is a valid CIDR
+func IsCIDR(str string) bool {
+	return ValidateCIDR(str) == nil
+}
+
+// ValidateCIDR returns a *FormatError when the string is not an IP address and
+// a prefix length in CIDR notation, e.g. 10.0.0.0/8 or 2001:db8::/32
+func ValidateCIDR(str string) error {
+	_, _, _, err := parseCIDR(str)
+	return err
+}
+
+// CIDR represents an IP address and a prefix length in CIDR notation, such as
+// 10.0.0.0/8 for a network or 192.168.1.5/24 for an address of this network.
+//
+// It is written in its canonical representation, e.g. an IPv6 address in lower case.
+// The zero value is written as an empty string, or NULL in a database, and read back from it.
+//
+// swagger:strfmt cidr
+type CIDR string
+
+// IPNet returns the network of the CIDR, with the bits of the address beyond
+// the prefix length cleared, nil when it isn't valid
+func (c CIDR) IPNet() *net.IPNet {
+	ip, v6, bits, err := parseCIDR(string(c))
+	if err != nil {
+		return nil
+	}
+	if !v6 {
+		ip = ip.To4()
+	}
+	mask := net.CIDRMask(bits, 8*len(ip))
+	return &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+}
+
+// IP returns the address of the CIDR, which may have bits set beyond the
+// prefix length, the empty IP when it isn't valid
+func (c CIDR) IP() IP {
+	ip, v6, _, err := parseCIDR(string(c))
+	if err != nil {
+		return ""
+	}
+	return IP(formatIP(ip, v6))
+}
+
+// Masked returns the network of the CIDR in CIDR notation, e.g. 192.168.1.0/24
+// for 192.168.1.5/24, unchanged when it isn't valid
+func (c CIDR) Masked() CIDR {
+	_, v6, bits, err := parseCIDR(string(c))
+	if err != nil {
+		return c
+	}
+	return CIDR(formatIP(c.IPNet().IP, v6) + "/" + strconv.Itoa(bits))
+}
+
+// Contains reports whether the network of the CIDR contains the IP address of
+// the same family
+func (c CIDR) Contains(ip IP) bool {
+	_, cv6, _, err := parseCIDR(string(c))
+	if err != nil {
+		return false
+	}
+	addr, v6, err := parseIP("ip", string(ip))
+	if err != nil || v6 != cv6 {
+		return false
+	}
+	return c.IPNet().Contains(addr)
+}
+
+// Overlaps reports whether the networks of the CIDRs have addresses in common,
+// which happens when one of them contains the other
+func (c CIDR) Overlaps(o CIDR) bool {
+	return c.Contains(o.Masked().IP()) || o.Contains(c.Masked().IP())
+}
+
+// Canonical returns the canonical representation of the CIDR, unchanged when it isn't valid
+func (c CIDR) Canonical() CIDR {
+	ip, v6, bits, err := parseCIDR(string(c))
+	if err != nil {
+		return c
+	}
+	return CIDR(formatIP(ip, v6) + "/" + strconv.Itoa(bits))
+}
+
+func (c *CIDR) canonicalize() {
+	*c = c.Canonical()
+}
+
+// String converts this CIDR to a string
+func (c CIDR) String() string {
+	return string(c)
+}
+
+// MarshalText serializes this CIDR in its canonical representation
+func (c CIDR) MarshalText() ([]byte, error) {
+	return []byte(c.Canonical()), nil
+}
+
+// UnmarshalText parses a text representation into a CIDR, the empty text into the zero value
+func (c *CIDR) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*c = ""
+		return nil
+	}
+	if err := ValidateCIDR(string(data)); err != nil {
+		return err
+	}
+	*c = CIDR(data)
+	return nil
+}
+
+// Scan scans a CIDR value from database driver type, such as a Postgres cidr column.
+func (c *CIDR) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return c.UnmarshalText(v)
+	case string:
+		return c.UnmarshalText([]byte(v))
+	case nil:
+		*c = CIDR("")
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.CIDR from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts CIDR to a primitive value ready to be written to a database, the zero value to NULL.
+func (c CIDR) Value() (driver.Value, error) {
+	if c == "" {
+		return nil, nil
+	}
+	return driver.Value(string(c.Canonical())), nil
+}
+
+// MarshalJSON returns the CIDR as JSON
+func (c CIDR) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	c.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the CIDR to a easyjson.Writer
+func (c CIDR) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(c.Canonical()))
+}
+
+// UnmarshalJSON sets the CIDR from JSON
+func (c *CIDR) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	c.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the CIDR from a easyjson.Lexer
+func (c *CIDR) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		if err := c.UnmarshalText([]byte(data)); err != nil {
+			in.AddError(err)
+		}
+	}
+}
+
+// GetBSON returns the CIDR as a bson.M{} map.
+func (c *CIDR) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(c.Canonical())}, nil
+}
+
+// SetBSON sets the CIDR from raw bson data
+func (c *CIDR) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return c.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as CIDR")
+}
+
+// IsPort returns true when the string is a valid port number
+func IsPort(str string) bool {
+	return ValidatePort(str) == nil
+}
+
+// ValidatePort returns a *FormatError when the string is not a port number from 1 to 65535
+func ValidatePort(str string) error {
+	_, err := ParsePort(str)
+	return err
+}
+
+// Port represents a TCP or UDP port number, from 1 to 65535
+//
+// The zero value is written as "0", or NULL in a database, and read back from
+// it as well as from the empty text.
+//
+// swagger:strfmt port
+type Port int
+
+// ParsePort parses a port number written in decimal, e.g. 8080
+func ParsePort(str string) (Port, error) {
+	const name = "port"
+	if str == "" {
+		return 0, newFormatError(name, str, 0, ReasonInvalidLength)
+	}
+	for i := 0; i < len(str); i++ {
+		if !isDigit(str[i]) {
+			return 0, newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+	}
+	p, err := strconv.Atoi(str)
+	if err != nil || p < 1 || p > 65535 {
+		return 0, newFormatError(name, str, 0, ReasonOutOfRange)
+	}
+	return Port(p), nil
+}
+
+// String converts this port number to decimal
+func (p Port) String() string {
+	return strconv.Itoa(int(p))
+}
+
+// MarshalText serializes this port number to decimal
+func (p Port) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText parses a text representation into a port number, the empty text
+// and "0" into the zero value
+func (p *Port) UnmarshalText(text []byte) error {
+	if len(text) == 0 || string(text) == "0" {
+		*p = 0
+		return nil
+	}
+	pp, err := ParsePort(string(text))
+	if err != nil {
+		return err
+	}
+	*p = pp
+	return nil
+}
+
+// Scan scans a Port value from database driver type, such as an integer column.
+func (p *Port) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return p.UnmarshalText(v)
+	case string:
+		return p.UnmarshalText([]byte(v))
+	case int64:
+		if v < 0 || v > 65535 {
+			return newFormatError("port", strconv.FormatInt(v, 10), 0, ReasonOutOfRange)
+		}
+		*p = Port(v)
+	case nil:
+		*p = Port(0)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Port from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts Port to an integer ready to be written to a database, the zero value to NULL.
+func (p Port) Value() (driver.Value, error) {
+	if p == 0 {
+		return nil, nil
+	}
+	return driver.Value(int64(p)), nil
+}
+
+// MarshalJSON returns the Port as JSON
+func (p Port) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	p.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the Port to a easyjson.Writer
+func (p Port) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(p.String())
+}
+
+// UnmarshalJSON sets the Port from JSON
+func (p *Port) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	p.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the Port from a easyjson.Lexer
+func (p *Port) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		if err := p.UnmarshalText([]byte(data)); err != nil {
+			in.AddError(err)
+		}
+	}
+}
+
+// GetBSON returns the Port as a bson.M{} map.
+func (p *Port) GetBSON() (interface{}, error) {
+	return bson.M{"data": p.String()}, nil
+}
+
+// SetBSON sets the Port from raw bson data
+func (p *Port) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return p.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as Port")
+}
+
+// parseHostPort parses a hostname or an IP address and a port, it returns the
+// host in its canonical representation, without brackets
+func parseHostPort(str string) (string, Port, error) {
+	const name = "host-port"
+	colon := strings.LastIndexByte(str, ':')
+	if colon < 0 {
+		return "", 0, newFormatError(name, str, len(str), ReasonInvalidSyntax)
+	}
+
+	var host string
+	switch h := str[:colon]; {
+	case h == "":
+		return "", 0, newFormatError(name, str, 0, ReasonInvalidLength)
+	case h[0] == '[':
+		// IPv6 addresses are enclosed in brackets
+		if len(h) < 2 || h[len(h)-1] != ']' {
+			return "", 0, newFormatError(name, str, colon, ReasonInvalidSyntax)
+		}
+		ip, v6, err := parseIP(name, h[1:len(h)-1])
+		if err != nil {
+			return "", 0, shiftFormatError(err, name, str, 1)
+		}
+		if !v6 {
+			return "", 0, newFormatError(name, str, 1, ReasonInvalidSyntax)
+		}
+		host = formatIP(ip, v6)
+	case strings.IndexByte(h, ':') >= 0:
+		return "", 0, newFormatError(name, str, strings.IndexByte(h, ':'), ReasonInvalidCharacter)
+	case net.ParseIP(h) != nil:
+		host = net.ParseIP(h).String()
+	default:
+		if err := ValidateHostname(h); err != nil {
+			return "", 0, shiftFormatError(err, name, str, 0)
+		}
+		host = strings.ToLower(h)
+	}
+
+	port, err := ParsePort(str[colon+1:])
+	if err != nil {
+		return "", 0, shiftFormatError(err, name, str, colon+1)
+	}
+	return host, port, nil
+}
+
+// IsHostPort returns true when the string is a valid host and port
+func IsHostPort(str string) bool {
+	return ValidateHostPort(str) == nil
+}
+
+// ValidateHostPort returns a *FormatError when the string is not a hostname
+// or an IP address and a port, e.g. example.com:443 or [::1]:8080
+func ValidateHostPort(str string) error {
+	_, _, err := parseHostPort(str)
+	return err
+}
+
+// HostPort represents a hostname or an IP address and a port, such as
+// example.com:443, 10.0.0.1:80 or [::1]:8080, where IPv6 addresses are
+// enclosed in brackets.
+//
+// It is written in its canonical representation, e.g. with a hostname in lower case.
+// The zero value is written as an empty string, or NULL in a database, and read back from it.
+//
+// swagger:strfmt host-port
+type HostPort string
+
+// NewHostPort returns the host and port, enclosing IPv6 addresses in brackets
+func NewHostPort(host string, port Port) HostPort {
+	return HostPort(net.JoinHostPort(host, port.String()))
+}
+
+// Host returns the hostname or the IP address, without brackets, the empty
+// string when the host and port aren't valid
+func (hp HostPort) Host() string {
+	host, _, _ := parseHostPort(string(hp))
+	return host
+}
+
+// Port returns the port, 0 when the host and port aren't valid
+func (hp HostPort) Port() Port {
+	_, port, _ := parseHostPort(string(hp))
+	return port
+}
+
+// Canonical returns the canonical representation of the host and port, unchanged when they aren't valid
+func (hp HostPort) Canonical() HostPort {
+	host, port, err := parseHostPort(string(hp))
+	if err != nil {
+		return hp
+	}
+	return NewHostPort(host, port)
+}
+
+func (hp *HostPort) canonicalize() {
+	*hp = hp.Canonical()
+}
+
+// String converts this host and port to a string
+func (hp HostPort) String() string {
+	return string(hp)
+}
+
+// MarshalText serializes this host and port in its canonical representation
+func (hp HostPort) MarshalText() ([]byte, error) {
+	return []byte(hp.Canonical()), nil
+}
+
+// UnmarshalText parses a text representation into a host and port, the empty text into the zero value
+func (hp *HostPort) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*hp = ""
+		return nil
+	}
+	if err := ValidateHostPort(string(data)); err != nil {
+		return err
+	}
+	*hp = HostPort(data)
+	return nil
+}
+
+// Scan scans a HostPort value from database driver type.
+func (hp *HostPort) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return hp.UnmarshalText(v)
+	case string:
+		return hp.UnmarshalText([]byte(v))
+	case nil:
+		*hp = HostPort("")
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.HostPort from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts HostPort to a primitive value ready to be written to a database, the zero value to NULL.
+func (hp HostPort) Value() (driver.Value, error) {
+	if hp == "" {
+		return nil, nil
+	}
+	return driver.Value(string(hp.Canonical())), nil
+}
+
+// MarshalJSON returns the HostPort as JSON
+func (hp HostPort) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	hp.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the HostPort to a easyjson.Writer
+func (hp HostPort) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(hp.Canonical()))
+}
+
+// UnmarshalJSON sets the HostPort from JSON
+func (hp *HostPort) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	hp.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the HostPort from a easyjson.Lexer
+func (hp *HostPort) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		if err := hp.UnmarshalText([]byte(data)); err != nil {
+			in.AddError(err)
+		}
+	}
+}
+
+// GetBSON returns the HostPort as a bson.M{} map.
+func (hp *HostPort) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(hp.Canonical())}, nil
+}
+
+// SetBSON sets the HostPort from raw bson data
+func (hp *HostPort) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return hp.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as HostPort")
+}
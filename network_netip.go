@@ -0,0 +1,66 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package strfmt
+
+import (
+	"net/netip"
+
+	"github.com/asaskevich/govalidator"
+)
+
+// Addr returns the IPv4 address, the zero Addr when it isn't valid
+func (u IPv4) Addr() netip.Addr {
+	if !govalidator.IsIPv4(string(u)) {
+		return netip.Addr{}
+	}
+	addr, _ := netip.ParseAddr(string(u))
+	return addr.Unmap()
+}
+
+// Addr returns the IPv6 address, the zero Addr when it isn't valid
+func (u IPv6) Addr() netip.Addr {
+	if !govalidator.IsIPv6(string(u)) {
+		return netip.Addr{}
+	}
+	addr, _ := netip.ParseAddr(string(u))
+	return addr
+}
+
+// Addr returns the IP address, the zero Addr when it isn't valid
+func (ip IP) Addr() netip.Addr {
+	if ValidateIP(string(ip)) != nil {
+		return netip.Addr{}
+	}
+	addr, _ := netip.ParseAddr(string(ip))
+	return addr
+}
+
+// Prefix returns the IP address and the prefix length of the CIDR, the zero
+// Prefix when it isn't valid
+func (c CIDR) Prefix() netip.Prefix {
+	if ValidateCIDR(string(c)) != nil {
+		return netip.Prefix{}
+	}
+	prefix, _ := netip.ParsePrefix(string(c.Canonical()))
+	return prefix
+}
+
+// ContainsAddr reports whether the network of the CIDR contains the address of the same family
+func (c CIDR) ContainsAddr(addr netip.Addr) bool {
+	return c.Prefix().Contains(addr)
+}
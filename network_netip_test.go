@@ -0,0 +1,55 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package strfmt
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddr(t *testing.T) {
+	assert.Equal(t, netip.MustParseAddr("192.168.254.1"), IPv4("192.168.254.1").Addr())
+	assert.Equal(t, netip.MustParseAddr("192.0.2.1"), IPv4("::ffff:192.0.2.1").Addr())
+	assert.False(t, IPv4("::1").Addr().IsValid())
+	assert.Equal(t, netip.MustParseAddr("2001:db8::68"), IPv6("2001:DB8::68").Addr())
+	assert.False(t, IPv6("127.0.0.1").Addr().IsValid())
+
+	for _, ip := range []IP{"192.168.1.1", "2001:DB8::68", "::ffff:192.0.2.1"} {
+		addr := ip.Addr()
+		assert.True(t, addr.IsValid(), ip)
+		assert.Equal(t, string(ip.Canonical()), addr.String(), "the canonical representations agree")
+	}
+	assert.False(t, IP("localhost").Addr().IsValid())
+}
+
+func TestCIDR_Prefix(t *testing.T) {
+	for _, c := range []CIDR{"10.0.0.0/8", "192.168.1.5/24", "2001:DB8::1/032", "::ffff:10.1.2.3/104"} {
+		prefix := c.Prefix()
+		assert.True(t, prefix.IsValid(), c)
+		assert.Equal(t, string(c.Canonical()), prefix.String(), "the canonical representations agree")
+		assert.Equal(t, string(c.Masked()), prefix.Masked().String())
+	}
+	assert.False(t, CIDR("10.0.0.0").Prefix().IsValid())
+
+	c := CIDR("10.1.2.3/16")
+	assert.True(t, c.ContainsAddr(netip.MustParseAddr("10.1.255.255")))
+	assert.False(t, c.ContainsAddr(netip.MustParseAddr("10.2.0.0")))
+	assert.False(t, c.ContainsAddr(netip.MustParseAddr("::ffff:10.1.2.3")))
+}
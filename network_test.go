@@ -0,0 +1,351 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net"
+	"testing"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIP(t *testing.T) {
+	testCases := []struct {
+		in        IP
+		canonical IP
+	}{
+		{"192.168.1.1", "192.168.1.1"},
+		{"2001:DB8:0:0:0:0:0:68", "2001:db8::68"},
+		{"::1", "::1"},
+		{"::FFFF:192.0.2.1", "::ffff:192.0.2.1"},
+		{"0:0:0:0:0:0:0:0", "::"},
+	}
+	for _, tc := range testCases {
+		assert.True(t, IsIP(string(tc.in)), tc.in)
+		assert.Equal(t, tc.canonical, tc.in.Canonical(), tc.in)
+		assert.True(t, net.ParseIP(string(tc.in)).Equal(tc.in.NetIP()), tc.in)
+
+		b, err := tc.in.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, string(tc.canonical), string(b))
+		var ip IP
+		assert.NoError(t, ip.UnmarshalText([]byte(tc.in)))
+		assert.Equal(t, tc.in, ip)
+	}
+
+	assertFormatError(t, ValidateIP(""), "ip", 0, ReasonInvalidLength)
+	assertFormatError(t, ValidateIP("2001:db8::g"), "ip", 10, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIP("fe80::1%eth0"), "ip", 7, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateIP("192.168.254.2.2"), "ip", -1, ReasonInvalid)
+	assert.Nil(t, IP("localhost").NetIP())
+	assert.Equal(t, IP("localhost"), IP("localhost").Canonical())
+}
+
+func TestIP_serialization(t *testing.T) {
+	ip := IP("2001:DB8::68")
+
+	bj, err := ip.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"2001:db8::68"`, string(bj))
+	var jsonCopy IP
+	assert.NoError(t, jsonCopy.UnmarshalJSON(bj))
+	assert.Equal(t, ip.Canonical(), jsonCopy)
+	assert.Error(t, jsonCopy.UnmarshalJSON([]byte(`"2001:db8::g"`)))
+
+	dv, err := ip.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2001:db8::68", dv)
+	var sqlCopy IP
+	assert.NoError(t, sqlCopy.Scan([]byte("2001:db8::68")))
+	assert.Equal(t, ip.Canonical(), sqlCopy)
+	assert.NoError(t, sqlCopy.Scan(nil))
+	assert.Equal(t, IP(""), sqlCopy)
+	assert.Error(t, sqlCopy.Scan(42))
+
+	bsonData, err := bson.Marshal(&ip)
+	assert.NoError(t, err)
+	var bsonCopy IP
+	assert.NoError(t, bson.Unmarshal(bsonData, &bsonCopy))
+	assert.Equal(t, ip.Canonical(), bsonCopy)
+}
+
+func TestCIDR(t *testing.T) {
+	testCases := []struct {
+		in        CIDR
+		canonical CIDR
+		masked    CIDR
+	}{
+		{"10.0.0.0/8", "10.0.0.0/8", "10.0.0.0/8"},
+		{"192.168.1.5/24", "192.168.1.5/24", "192.168.1.0/24"},
+		{"2001:DB8::1/032", "2001:db8::1/32", "2001:db8::/32"},
+		{"::/0", "::/0", "::/0"},
+		{"::ffff:10.1.2.3/104", "::ffff:10.1.2.3/104", "::ffff:10.0.0.0/104"},
+	}
+	for _, tc := range testCases {
+		assert.NoError(t, ValidateCIDR(string(tc.in)), tc.in)
+		assert.Equal(t, tc.canonical, tc.in.Canonical(), tc.in)
+		assert.Equal(t, tc.masked, tc.in.Masked(), tc.in)
+		b, err := tc.in.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, string(tc.canonical), string(b))
+	}
+
+	_, network, _ := net.ParseCIDR("192.168.1.0/24")
+	assert.Equal(t, network, CIDR("192.168.1.5/24").IPNet())
+	assert.Equal(t, IP("192.168.1.5"), CIDR("192.168.1.5/24").IP())
+
+	assertFormatError(t, ValidateCIDR("10.0.0.0"), "cidr", 8, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateCIDR("/8"), "cidr", 0, ReasonInvalidLength)
+	assertFormatError(t, ValidateCIDR("10.0.0.x/8"), "cidr", 7, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateCIDR("10.0.0/8"), "cidr", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateCIDR("10.0.0.0/"), "cidr", 9, ReasonInvalidLength)
+	assertFormatError(t, ValidateCIDR("10.0.0.0/-1"), "cidr", 9, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateCIDR("10.0.0.0/33"), "cidr", 9, ReasonOutOfRange)
+	assertFormatError(t, ValidateCIDR("2001:db8::/129"), "cidr", 11, ReasonOutOfRange)
+	assert.Nil(t, CIDR("10.0.0.0").IPNet())
+	assert.Equal(t, IP(""), CIDR("10.0.0.0").IP())
+}
+
+func TestCIDR_Contains(t *testing.T) {
+	c := CIDR("10.1.2.3/16")
+	assert.True(t, c.Contains("10.1.0.0"))
+	assert.True(t, c.Contains("10.1.255.255"))
+	assert.False(t, c.Contains("10.2.0.0"))
+	assert.False(t, c.Contains("::ffff:10.1.2.3"), "the families differ")
+	assert.False(t, c.Contains("not an ip"))
+	assert.False(t, CIDR("10.1.0.0").Contains("10.1.0.0"))
+
+	assert.True(t, CIDR("2001:db8::/32").Contains("2001:DB8:1::1"))
+	assert.False(t, CIDR("2001:db8::/32").Contains("2001:db9::1"))
+	assert.True(t, CIDR("::/0").Contains("::1"))
+	assert.False(t, CIDR("::/0").Contains("10.0.0.1"))
+
+	assert.True(t, c.Overlaps("10.0.0.0/8"))
+	assert.True(t, CIDR("10.0.0.0/8").Overlaps(c))
+	assert.True(t, c.Overlaps("10.1.128.0/17"))
+	assert.False(t, c.Overlaps("10.2.0.0/16"))
+	assert.False(t, c.Overlaps("::/0"))
+}
+
+func TestCIDR_serialization(t *testing.T) {
+	c := CIDR("2001:DB8::/32")
+
+	bj, err := c.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"2001:db8::/32"`, string(bj))
+	var jsonCopy CIDR
+	assert.NoError(t, jsonCopy.UnmarshalJSON(bj))
+	assert.Equal(t, c.Canonical(), jsonCopy)
+	assert.Error(t, jsonCopy.UnmarshalJSON([]byte(`"2001:db8::"`)))
+
+	dv, err := c.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2001:db8::/32", dv)
+	var sqlCopy CIDR
+	assert.NoError(t, sqlCopy.Scan("2001:db8::/32"))
+	assert.Equal(t, c.Canonical(), sqlCopy)
+	assert.Error(t, sqlCopy.Scan(42))
+
+	bsonData, err := bson.Marshal(&c)
+	assert.NoError(t, err)
+	var bsonCopy CIDR
+	assert.NoError(t, bson.Unmarshal(bsonData, &bsonCopy))
+	assert.Equal(t, c.Canonical(), bsonCopy)
+}
+
+func TestPort(t *testing.T) {
+	for _, str := range []string{"1", "80", "08080", "65535"} {
+		assert.True(t, IsPort(str), str)
+	}
+	p, err := ParsePort("08080")
+	assert.NoError(t, err)
+	assert.Equal(t, Port(8080), p)
+	assert.Equal(t, "8080", p.String())
+
+	assertFormatError(t, ValidatePort(""), "port", 0, ReasonInvalidLength)
+	assertFormatError(t, ValidatePort("80a"), "port", 2, ReasonInvalidCharacter)
+	assertFormatError(t, ValidatePort("-1"), "port", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidatePort("0"), "port", 0, ReasonOutOfRange)
+	assertFormatError(t, ValidatePort("65536"), "port", 0, ReasonOutOfRange)
+	assertFormatError(t, ValidatePort("99999999999999999999"), "port", 0, ReasonOutOfRange)
+
+	bj, err := p.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"8080"`, string(bj))
+	var jsonCopy Port
+	assert.NoError(t, jsonCopy.UnmarshalJSON(bj))
+	assert.Equal(t, p, jsonCopy)
+	assert.Error(t, jsonCopy.UnmarshalJSON([]byte(`"65536"`)))
+
+	dv, err := p.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(8080), dv)
+	var sqlCopy Port
+	assert.NoError(t, sqlCopy.Scan(dv))
+	assert.Equal(t, p, sqlCopy)
+	assert.NoError(t, sqlCopy.Scan([]byte("443")))
+	assert.Equal(t, Port(443), sqlCopy)
+	assert.Error(t, sqlCopy.Scan(4.2))
+	assertFormatError(t, sqlCopy.Scan(int64(65536)), "port", 0, ReasonOutOfRange)
+	assertFormatError(t, sqlCopy.Scan(int64(-1)), "port", 0, ReasonOutOfRange)
+	assert.Equal(t, Port(443), sqlCopy)
+
+	bsonData, err := bson.Marshal(&p)
+	assert.NoError(t, err)
+	var bsonCopy Port
+	assert.NoError(t, bson.Unmarshal(bsonData, &bsonCopy))
+	assert.Equal(t, p, bsonCopy)
+}
+
+func TestHostPort(t *testing.T) {
+	testCases := []struct {
+		in        HostPort
+		host      string
+		port      Port
+		canonical HostPort
+	}{
+		{"example.com:443", "example.com", 443, "example.com:443"},
+		{"Example.COM:0443", "example.com", 443, "example.com:443"},
+		{"localhost:8080", "localhost", 8080, "localhost:8080"},
+		{"10.0.0.1:80", "10.0.0.1", 80, "10.0.0.1:80"},
+		{"[::1]:8080", "::1", 8080, "[::1]:8080"},
+		{"[2001:DB8::68]:53", "2001:db8::68", 53, "[2001:db8::68]:53"},
+	}
+	for _, tc := range testCases {
+		assert.NoError(t, ValidateHostPort(string(tc.in)), tc.in)
+		assert.Equal(t, tc.host, tc.in.Host(), tc.in)
+		assert.Equal(t, tc.port, tc.in.Port(), tc.in)
+		assert.Equal(t, tc.canonical, tc.in.Canonical(), tc.in)
+		b, err := tc.in.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, string(tc.canonical), string(b))
+	}
+	assert.Equal(t, HostPort("[::1]:8080"), NewHostPort("::1", 8080))
+	assert.Equal(t, HostPort("example.com:443"), NewHostPort("example.com", 443))
+
+	assertFormatError(t, ValidateHostPort("example.com"), "host-port", 11, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateHostPort(":8080"), "host-port", 0, ReasonInvalidLength)
+	assertFormatError(t, ValidateHostPort("::1:8080"), "host-port", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateHostPort("[::1:8080"), "host-port", 4, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateHostPort("[10.0.0.1]:80"), "host-port", 1, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateHostPort("[::g]:80"), "host-port", 3, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateHostPort("exa_mple.com:80"), "host-port", 3, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateHostPort("example.com:0"), "host-port", 12, ReasonOutOfRange)
+	assertFormatError(t, ValidateHostPort("example.com:http"), "host-port", 12, ReasonInvalidCharacter)
+	assert.Equal(t, "", HostPort("example.com").Host())
+	assert.Equal(t, Port(0), HostPort("example.com").Port())
+}
+
+func TestHostPort_serialization(t *testing.T) {
+	hp := HostPort("[2001:DB8::68]:53")
+
+	bj, err := hp.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"[2001:db8::68]:53"`, string(bj))
+	var jsonCopy HostPort
+	assert.NoError(t, jsonCopy.UnmarshalJSON(bj))
+	assert.Equal(t, hp.Canonical(), jsonCopy)
+	assert.Error(t, jsonCopy.UnmarshalJSON([]byte(`"2001:db8::68:53"`)))
+
+	dv, err := hp.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "[2001:db8::68]:53", dv)
+	var sqlCopy HostPort
+	assert.NoError(t, sqlCopy.Scan("[2001:db8::68]:53"))
+	assert.Equal(t, hp.Canonical(), sqlCopy)
+	assert.Error(t, sqlCopy.Scan(42))
+
+	bsonData, err := bson.Marshal(&hp)
+	assert.NoError(t, err)
+	var bsonCopy HostPort
+	assert.NoError(t, bson.Unmarshal(bsonData, &bsonCopy))
+	assert.Equal(t, hp.Canonical(), bsonCopy)
+}
+
+func TestNetwork_zero(t *testing.T) {
+	type endpoint struct {
+		IP       IP       `json:"ip"`
+		CIDR     CIDR     `json:"cidr"`
+		Port     Port     `json:"port"`
+		HostPort HostPort `json:"hostPort"`
+	}
+	js, err := json.Marshal(endpoint{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"ip":"","cidr":"","port":"0","hostPort":""}`, string(js))
+	back := endpoint{IP: "10.0.0.1", CIDR: "10.0.0.0/8", Port: 80, HostPort: "example.com:443"}
+	assert.NoError(t, json.Unmarshal(js, &back))
+	assert.Equal(t, endpoint{}, back)
+	assert.NoError(t, json.Unmarshal([]byte(`{"port":""}`), &back))
+	assert.Equal(t, endpoint{}, back)
+
+	for _, v := range []driver.Valuer{IP(""), CIDR(""), Port(0), HostPort("")} {
+		val, err := v.Value()
+		assert.NoError(t, err)
+		assert.Nil(t, val, "%T", v)
+	}
+
+	ip, cidr, port, hp := IP("10.0.0.1"), CIDR("10.0.0.0/8"), Port(80), HostPort("example.com:443")
+	assert.NoError(t, ip.UnmarshalText(nil))
+	assert.NoError(t, cidr.UnmarshalText(nil))
+	assert.NoError(t, port.UnmarshalText(nil))
+	assert.NoError(t, hp.UnmarshalText(nil))
+	assert.Equal(t, []interface{}{IP(""), CIDR(""), Port(0), HostPort("")}, []interface{}{ip, cidr, port, hp})
+
+	ip, cidr, port, hp = IP("10.0.0.1"), CIDR("10.0.0.0/8"), Port(80), HostPort("example.com:443")
+	assert.NoError(t, ip.Scan(""))
+	assert.NoError(t, cidr.Scan([]byte{}))
+	assert.NoError(t, port.Scan(int64(0)))
+	assert.NoError(t, hp.Scan(nil))
+	assert.Equal(t, []interface{}{IP(""), CIDR(""), Port(0), HostPort("")}, []interface{}{ip, cidr, port, hp})
+
+	zeroIP, zeroPort := IP(""), Port(0)
+	bsonData, err := bson.Marshal(&zeroIP)
+	assert.NoError(t, err)
+	ip = IP("10.0.0.1")
+	assert.NoError(t, bson.Unmarshal(bsonData, &ip))
+	assert.Equal(t, IP(""), ip)
+	bsonData, err = bson.Marshal(&zeroPort)
+	assert.NoError(t, err)
+	port = Port(80)
+	assert.NoError(t, bson.Unmarshal(bsonData, &port))
+	assert.Equal(t, Port(0), port)
+
+	// the zero values aren't valid formats though
+	assert.Error(t, ValidateIP(""))
+	assert.Error(t, ValidateCIDR(""))
+	assert.Error(t, ValidatePort("0"))
+	assert.Error(t, ValidateHostPort(""))
+}
+
+func TestNetworkFormats_registry(t *testing.T) {
+	registry := NewFormats().(*defaultFormats)
+	for _, name := range []string{"ip", "cidr", "host-port"} {
+		assert.True(t, registry.Canonicalize(name), name)
+	}
+	assert.False(t, registry.Canonicalize("port"))
+
+	v, err := registry.Parse("ip", "2001:DB8::68")
+	assert.NoError(t, err)
+	assert.Equal(t, IP("2001:db8::68"), *v.(*IP))
+	v, err = registry.Parse("hostport", "Example.com:443")
+	assert.NoError(t, err)
+	assert.Equal(t, HostPort("example.com:443"), *v.(*HostPort))
+	_, err = registry.Parse("cidr", "10.0.0.0")
+	assert.Error(t, err)
+}
@@ -0,0 +1,290 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func init() {
+	p := Period{}
+	// register this format in the default registry
+	AddWithInfo(Default, "period", &p, ValidatePeriod, FormatInfo{
+		Description:     "A calendar period, as an ISO 8601 duration of years, months, weeks, days and time, where each number may be negative",
+		Examples:        []string{"P1Y", "P1M", "P2W", "P1Y2M10DT2H30M", "-P1D", "P1M-1D", "PT0.5S"},
+		InvalidExamples: []string{"P", "PT", "P1D2H", "P1M1Y", "P1.5D", "1 month"},
+	})
+}
+
+// IsPeriod returns true if the provided string is a valid period
+func IsPeriod(str string) bool {
+	return ValidatePeriod(str) == nil
+}
+
+// ValidatePeriod returns a *FormatError when the string is not a valid period
+func ValidatePeriod(str string) error {
+	_, err := ParsePeriod(str)
+	return err
+}
+
+// Period represents a calendar period, such as 1 month or 1 year and 2 days.
+//
+// Unlike a Duration, the length of a period depends on the time it is added to:
+// a month may last from 28 to 31 days, and a day 23 or 25 hours when the clocks
+// change for daylight saving time.
+//
+// swagger:strfmt period
+type Period struct {
+	Years  int
+	Months int
+	Weeks  int
+	Days   int
+	// Time is the time component of the period, in hours, minutes and seconds
+	Time time.Duration
+}
+
+// ParsePeriod parses an ISO 8601 duration as a period, e.g. "P1Y2M10DT2H30M".
+//
+// Each number may be negative, e.g. "P1M-1D", and the whole period as well, e.g. "-P1D".
+// Only the numbers of the time component may have a fraction.
+func ParsePeriod(str string) (Period, error) {
+	const name = "period"
+	if !isISODuration(str) {
+		return Period{}, newFormatError(name, str, 0, ReasonInvalidSyntax)
+	}
+	negative, elements, bad := parseISOElements(str, true)
+	if bad >= 0 {
+		return Period{}, newFormatError(name, str, bad, ReasonInvalidSyntax)
+	}
+
+	var p Period
+	for _, e := range elements {
+		if !e.inTime {
+			if e.nanos != 0 {
+				// calendar elements can't be split
+				return Period{}, newFormatError(name, str, e.offset, ReasonInvalidSyntax)
+			}
+			if int64(int(e.value)) != e.value {
+				return Period{}, newFormatError(name, str, e.offset, ReasonOutOfRange)
+			}
+			switch e.designator {
+			case 'Y':
+				p.Years = int(e.value)
+			case 'M':
+				p.Months = int(e.value)
+			case 'W':
+				p.Weeks = int(e.value)
+			case 'D':
+				p.Days = int(e.value)
+			}
+			continue
+		}
+
+		if e.value > math.MaxInt64/int64(e.unit)-1 || e.value < math.MinInt64/int64(e.unit)+1 {
+			return Period{}, newFormatError(name, str, e.offset, ReasonOutOfRange)
+		}
+		value := time.Duration(e.value)*e.unit + time.Duration(e.nanos*int64(e.unit/time.Second))
+		if value > 0 && p.Time > math.MaxInt64-value || value < 0 && p.Time < math.MinInt64-value {
+			return Period{}, newFormatError(name, str, e.offset, ReasonOutOfRange)
+		}
+		p.Time += value
+	}
+	if negative {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// IsZero returns true when the period is empty
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns the opposite of the period
+func (p Period) Negate() Period {
+	return Period{Years: -p.Years, Months: -p.Months, Weeks: -p.Weeks, Days: -p.Days, Time: -p.Time}
+}
+
+// AddTo returns t plus the period.
+//
+// Years and months are added first, keeping the day of the month unless it is
+// past the end of the resulting month (e.g. January 31st plus 1 month is
+// February 28th or 29th). Weeks and days are added next, keeping the clock
+// time across daylight saving time changes. The time component is added last,
+// as elapsed time.
+func (p Period) AddTo(t time.Time) time.Time {
+	if p.Years != 0 || p.Months != 0 {
+		t = addMonths(t, 12*p.Years+p.Months)
+	}
+	if p.Weeks != 0 || p.Days != 0 {
+		t = t.AddDate(0, 0, 7*p.Weeks+p.Days)
+	}
+	return t.Add(p.Time)
+}
+
+// addMonths returns t plus a number of months, keeping the day of the month
+// unless it is past the end of the resulting month, and the clock time
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	// the first day of the resulting month, normalized by time.Date
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, hour, min, sec, t.Nanosecond(), t.Location())
+}
+
+// String converts this period to an ISO 8601 duration.
+//
+// A period with weeks and other elements is written with days, since ISO 8601
+// doesn't combine weeks with other elements. A period with elements of
+// different signs is written with a sign for each negative number, e.g. "P1M-1D".
+func (p Period) String() string {
+	if p.Weeks != 0 && (p.Years != 0 || p.Months != 0 || p.Days != 0 || p.Time != 0) {
+		p.Weeks, p.Days = 0, p.Days+7*p.Weeks
+	}
+	// weeks and days may cancel each other out
+	if p.IsZero() {
+		return "P0D"
+	}
+	var buf []byte
+	if p.Years <= 0 && p.Months <= 0 && p.Weeks <= 0 && p.Days <= 0 && p.Time <= 0 {
+		buf = append(buf, '-')
+		p = Period{Years: -p.Years, Months: -p.Months, Weeks: -p.Weeks, Days: -p.Days, Time: p.Time}
+	}
+	buf = append(buf, 'P')
+
+	for _, e := range []struct {
+		value      int
+		designator byte
+	}{{p.Years, 'Y'}, {p.Months, 'M'}, {p.Weeks, 'W'}, {p.Days, 'D'}} {
+		if e.value != 0 {
+			buf = strconv.AppendInt(buf, int64(e.value), 10)
+			buf = append(buf, e.designator)
+		}
+	}
+
+	if p.Time != 0 {
+		buf = append(buf, 'T')
+		// the time keeps its sign when the period is negated, so the smallest
+		// duration doesn't overflow
+		switch {
+		case p.Time > 0:
+			buf = appendISOTime(buf, uint64(p.Time), "")
+		case buf[0] == '-':
+			buf = appendISOTime(buf, uint64(-p.Time), "")
+		default:
+			buf = appendISOTime(buf, uint64(-p.Time), "-")
+		}
+	}
+	return string(buf)
+}
+
+// MarshalText turns this instance into text
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText hydrates this instance from text
+func (p *Period) UnmarshalText(data []byte) error {
+	pp, err := ParsePeriod(string(data))
+	if err != nil {
+		return err
+	}
+	*p = pp
+	return nil
+}
+
+// Scan reads a Period value from database driver type.
+func (p *Period) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return p.UnmarshalText(v)
+	case string:
+		return p.UnmarshalText([]byte(v))
+	case nil:
+		*p = Period{}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Period from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts Period to a primitive value ready to be written to a database.
+func (p Period) Value() (driver.Value, error) {
+	return driver.Value(p.String()), nil
+}
+
+// MarshalJSON returns the Period as JSON
+func (p Period) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	p.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the Period to a easyjson.Writer
+func (p Period) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(p.String())
+}
+
+// UnmarshalJSON sets the Period from JSON
+func (p *Period) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	p.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the Period from a easyjson.Lexer
+func (p *Period) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		pp, err := ParsePeriod(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*p = pp
+	}
+}
+
+// GetBSON returns the Period as a bson.M{} map.
+func (p *Period) GetBSON() (interface{}, error) {
+	return bson.M{"data": p.String()}, nil
+}
+
+// SetBSON sets the Period from raw bson data
+func (p *Period) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return p.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as Period")
+}
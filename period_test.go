@@ -0,0 +1,172 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePeriod(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want Period
+		str  string
+	}{
+		{"P1Y", Period{Years: 1}, "P1Y"},
+		{"P1M", Period{Months: 1}, "P1M"},
+		{"P2W", Period{Weeks: 2}, "P2W"},
+		{"P1Y2M10DT2H30M", Period{Years: 1, Months: 2, Days: 10, Time: 2*time.Hour + 30*time.Minute}, "P1Y2M10DT2H30M"},
+		{"PT36H", Period{Time: 36 * time.Hour}, "PT36H"},
+		{"PT0,5S", Period{Time: 500 * time.Millisecond}, "PT0.5S"},
+		{"P0D", Period{}, "P0D"},
+		{"PT0S", Period{}, "P0D"},
+		{"-P1D", Period{Days: -1}, "-P1D"},
+		{"-P1YT1H", Period{Years: -1, Time: -time.Hour}, "-P1YT1H"},
+		{"+P1D", Period{Days: 1}, "P1D"},
+		{"P1M-1D", Period{Months: 1, Days: -1}, "P1M-1D"},
+		{"-P1M-1D", Period{Months: -1, Days: 1}, "P-1M1D"},
+		{"PT1H-30M", Period{Time: 30 * time.Minute}, "PT30M"},
+		{"P1DT-1H-0.5S", Period{Days: 1, Time: -time.Hour - 500*time.Millisecond}, "P1DT-1H-0.5S"},
+	}
+	for _, tc := range testCases {
+		got, err := ParsePeriod(tc.in)
+		if assert.NoError(t, err, tc.in) {
+			assert.Equal(t, tc.want, got, tc.in)
+			assert.Equal(t, tc.str, got.String(), tc.in)
+		}
+		assert.True(t, IsPeriod(tc.in), tc.in)
+	}
+
+	errorCases := []struct {
+		in     string
+		offset int
+		reason Reason
+	}{
+		{"", 0, ReasonInvalidSyntax},
+		{"1 month", 0, ReasonInvalidSyntax},
+		{"P", 1, ReasonInvalidSyntax},
+		{"PT", 2, ReasonInvalidSyntax},
+		{"P1D2H", 4, ReasonInvalidSyntax},
+		{"P1M1Y", 4, ReasonInvalidSyntax},
+		{"P1Y2W", 4, ReasonInvalidSyntax},
+		{"P1.5D", 1, ReasonInvalidSyntax},
+		{"P--1D", 2, ReasonInvalidSyntax},
+		{"PT9999999999H", 2, ReasonOutOfRange},
+		{"P99999999999999999999D", 1, ReasonInvalidSyntax},
+	}
+	for _, tc := range errorCases {
+		_, err := ParsePeriod(tc.in)
+		assertFormatError(t, err, "period", tc.offset, tc.reason)
+		assert.False(t, IsPeriod(tc.in), tc.in)
+	}
+}
+
+func TestPeriod_String(t *testing.T) {
+	// weeks are only written alone
+	assert.Equal(t, "P9D", Period{Weeks: 1, Days: 2}.String())
+	assert.Equal(t, "P1MT1H", Period{Months: 1, Time: time.Hour}.String())
+	assert.Equal(t, "P1M7DT1H", Period{Months: 1, Weeks: 1, Time: time.Hour}.String())
+	assert.Equal(t, "-P2W", Period{Weeks: -2}.String())
+	assert.Equal(t, "P1MT-1H", Period{Months: 1, Time: -time.Hour}.String())
+	assert.Equal(t, "P0D", Period{Weeks: -1, Days: 7}.String())
+	assert.Equal(t, "-P13D", Period{Weeks: -2, Days: 1}.String())
+}
+
+func TestPeriod_AddTo(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+	jan31 := time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		period Period
+		from   time.Time
+		want   time.Time
+	}{
+		// month lengths
+		{Period{Months: 1}, jan31, time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC)},
+		{Period{Months: 1}, time.Date(2023, 1, 31, 10, 0, 0, 0, time.UTC), time.Date(2023, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{Period{Months: 2}, jan31, time.Date(2024, 3, 31, 10, 0, 0, 0, time.UTC)},
+		{Period{Months: -2}, jan31, time.Date(2023, 11, 30, 10, 0, 0, 0, time.UTC)},
+		{Period{Years: 1}, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC)},
+		{Period{Months: 13}, jan31, time.Date(2025, 2, 28, 10, 0, 0, 0, time.UTC)},
+		{Period{Months: 1, Days: 1}, jan31, time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)},
+		{Period{Weeks: 1, Days: -1}, jan31, time.Date(2024, 2, 6, 10, 0, 0, 0, time.UTC)},
+		{Period{Time: 36 * time.Hour}, jan31, time.Date(2024, 2, 1, 22, 0, 0, 0, time.UTC)},
+		// daylight saving time starts on March 10th 2024 in New York
+		{Period{Days: 1}, time.Date(2024, 3, 9, 12, 0, 0, 0, newYork), time.Date(2024, 3, 10, 12, 0, 0, 0, newYork)},
+		{Period{Time: 24 * time.Hour}, time.Date(2024, 3, 9, 12, 0, 0, 0, newYork), time.Date(2024, 3, 10, 13, 0, 0, 0, newYork)},
+		{Period{Months: 1}, time.Date(2024, 2, 10, 12, 0, 0, 0, newYork), time.Date(2024, 3, 10, 12, 0, 0, 0, newYork)},
+	}
+	for _, tc := range testCases {
+		got := tc.period.AddTo(tc.from)
+		assert.True(t, tc.want.Equal(got), "%v + %v: expected %v, got %v", tc.from, tc.period, tc.want, got)
+		assert.Equal(t, tc.from.Location(), got.Location())
+	}
+}
+
+func TestPeriod_marshaling(t *testing.T) {
+	p := Period{Years: 1, Months: 2, Days: 3, Time: time.Hour}
+	const str = "P1Y2M3DT1H"
+
+	txt, err := p.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, str, string(txt))
+
+	b, err := p.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+str+`"`, string(b))
+
+	val, err := p.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, str, val)
+
+	var back Period
+	assert.NoError(t, back.UnmarshalText(txt))
+	assert.Equal(t, p, back)
+	assert.Error(t, back.UnmarshalText([]byte("1 month")))
+
+	back = Period{}
+	assert.NoError(t, back.UnmarshalJSON(b))
+	assert.Equal(t, p, back)
+	assert.Error(t, back.UnmarshalJSON([]byte(`"P1D2H"`)))
+	assert.Error(t, back.UnmarshalJSON([]byte(`12`)))
+
+	for _, raw := range []interface{}{str, []byte(str)} {
+		back = Period{}
+		assert.NoError(t, back.Scan(raw))
+		assert.Equal(t, p, back)
+	}
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, Period{}, back)
+	assert.Error(t, back.Scan(int64(1)))
+
+	bsonData, err := bson.Marshal(&p)
+	assert.NoError(t, err)
+	back = Period{}
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, p, back)
+
+	// the format is registered
+	v, err := Default.Parse("period", str)
+	assert.NoError(t, err)
+	assert.Equal(t, &p, v)
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+// IsJSONPointer returns true when the string is a valid JSON pointer
+func IsJSONPointer(str string) bool {
+	return ValidateJSONPointer(str) == nil
+}
+
+// ValidateJSONPointer returns a *FormatError when the string is not a JSON pointer, as defined by RFC 6901
+func ValidateJSONPointer(str string) error {
+	return validateJSONPointer("json-pointer", str, 0)
+}
+
+// IsRelativeJSONPointer returns true when the string is a valid relative JSON pointer
+func IsRelativeJSONPointer(str string) bool {
+	return ValidateRelativeJSONPointer(str) == nil
+}
+
+// ValidateRelativeJSONPointer returns a *FormatError when the string is not a
+// relative JSON pointer: a non-negative integer followed by either "#" or a JSON pointer
+func ValidateRelativeJSONPointer(str string) error {
+	const name = "relative-json-pointer"
+	i := 0
+	for i < len(str) && isDigit(str[i]) {
+		i++
+	}
+	switch {
+	case i == 0:
+		return newFormatError(name, str, 0, ReasonInvalidSyntax)
+	case str[0] == '0' && i > 1:
+		// no leading zero
+		return newFormatError(name, str, 1, ReasonInvalidCharacter)
+	case str[i:] == "#":
+		return nil
+	}
+	return validateJSONPointer(name, str, i)
+}
+
+// validateJSONPointer checks the JSON pointer str[start:]:
+//
+//	json-pointer = *( "/" reference-token )
+//	reference-token = *( unescaped / "~" ( "0" / "1" ) )
+func validateJSONPointer(name, str string, start int) error {
+	if start < len(str) && str[start] != '/' {
+		return newFormatError(name, str, start, ReasonInvalidSyntax)
+	}
+	for i := start; i < len(str); i++ {
+		if str[i] == '~' && (i+1 == len(str) || str[i+1] != '0' && str[i+1] != '1') {
+			return newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+	}
+	return nil
+}
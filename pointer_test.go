@@ -0,0 +1,47 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateJSONPointer(t *testing.T) {
+	for _, str := range []string{"", "/", "/foo//bar", "/foo/bar~0/baz~1/%a", "/i\\j", "/ ", "/~1~0~0~1~1"} {
+		assert.NoError(t, ValidateJSONPointer(str), str)
+		assert.True(t, IsJSONPointer(str), str)
+	}
+
+	assertFormatError(t, ValidateJSONPointer("#/"), "json-pointer", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateJSONPointer("a/a"), "json-pointer", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateJSONPointer("/foo/bar~"), "json-pointer", 8, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateJSONPointer("/~0~2"), "json-pointer", 3, ReasonInvalidCharacter)
+}
+
+func TestValidateRelativeJSONPointer(t *testing.T) {
+	for _, str := range []string{"0", "1", "120/foo/bar", "0/foo/bar", "2/0/baz/1/zip", "0#"} {
+		assert.NoError(t, ValidateRelativeJSONPointer(str), str)
+		assert.True(t, IsRelativeJSONPointer(str), str)
+	}
+
+	assertFormatError(t, ValidateRelativeJSONPointer(""), "relative-json-pointer", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRelativeJSONPointer("/foo/bar"), "relative-json-pointer", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRelativeJSONPointer("-1/foo/bar"), "relative-json-pointer", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRelativeJSONPointer("01/a"), "relative-json-pointer", 1, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateRelativeJSONPointer("0##"), "relative-json-pointer", 1, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRelativeJSONPointer("1/a~"), "relative-json-pointer", 3, ReasonInvalidCharacter)
+}
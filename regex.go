@@ -0,0 +1,473 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// IsRegex returns true when the string is a valid ECMA-262 regular expression
+func IsRegex(str string) bool {
+	return ValidateRegex(str) == nil
+}
+
+// ValidateRegex returns a *FormatError when the string is not a valid ECMA-262
+// regular expression.
+//
+// The pattern is checked with the syntax of the unicode mode ("u" flag), which
+// JSON schema validators use to evaluate patterns. The go regexp package uses a
+// different syntax and can't be used to check or evaluate these patterns.
+func ValidateRegex(str string) error {
+	p := regexParser{src: str}
+	if !p.parse() {
+		return newFormatError("regex", str, p.pos, p.reason)
+	}
+	return nil
+}
+
+// maxRegexDepth is the maximum nesting depth of the groups of a regular
+// expression, which bounds the recursion of the parser on untrusted patterns
+const maxRegexDepth = 1000
+
+// regexParser is a recursive descent parser of the ECMA-262 pattern grammar,
+// which only checks the syntax of the pattern
+type regexParser struct {
+	src    string
+	pos    int
+	reason Reason
+	depth  int
+
+	groups int
+	names  map[string]bool
+	refs   []regexBackref
+}
+
+// regexBackref is a back reference to a group by number or by name
+type regexBackref struct {
+	offset int
+	group  int
+	name   string
+}
+
+func (p *regexParser) fail(reason Reason) bool {
+	p.reason = reason
+	return false
+}
+
+func (p *regexParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *regexParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *regexParser) consume(prefix string) bool {
+	if strings.HasPrefix(p.src[p.pos:], prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+func (p *regexParser) parse() bool {
+	if !p.disjunction() {
+		return false
+	}
+	if !p.eof() {
+		// an unbalanced closing parenthesis
+		return p.fail(ReasonInvalidCharacter)
+	}
+	// back references must refer to existing groups
+	for _, ref := range p.refs {
+		if ref.name != "" && !p.names[ref.name] || ref.group > p.groups {
+			p.pos = ref.offset
+			return p.fail(ReasonOutOfRange)
+		}
+	}
+	return true
+}
+
+// Disjunction :: Alternative ( "|" Alternative )*
+func (p *regexParser) disjunction() bool {
+	for {
+		if !p.alternative() {
+			return false
+		}
+		if !p.consume("|") {
+			return true
+		}
+	}
+}
+
+// Alternative :: Term*
+func (p *regexParser) alternative() bool {
+	for !p.eof() && p.peek() != '|' && p.peek() != ')' {
+		if !p.term() {
+			return false
+		}
+	}
+	return true
+}
+
+// Term :: Assertion | Atom Quantifier?
+func (p *regexParser) term() bool {
+	start := p.pos
+	switch {
+	case p.consume("^"), p.consume("$"), p.consume(`\b`), p.consume(`\B`):
+		return p.noQuantifier()
+	case p.consume("(?="), p.consume("(?!"), p.consume("(?<="), p.consume("(?<!"):
+		if !p.group(start) {
+			return false
+		}
+		return p.noQuantifier()
+	}
+	if !p.atom() {
+		return false
+	}
+	return p.quantifier()
+}
+
+func (p *regexParser) noQuantifier() bool {
+	if c := p.peek(); c == '*' || c == '+' || c == '?' || c == '{' {
+		return p.fail(ReasonInvalidSyntax)
+	}
+	return true
+}
+
+// Quantifier :: ( "*" | "+" | "?" | "{" n ( "," m? )? "}" ) "?"?
+func (p *regexParser) quantifier() bool {
+	switch p.peek() {
+	case '*', '+', '?':
+		p.pos++
+	case '{':
+		start := p.pos
+		p.pos++
+		min, ok := p.decimal()
+		if !ok {
+			p.pos = start
+			return p.fail(ReasonInvalidSyntax)
+		}
+		max := min
+		if p.consume(",") {
+			max = -1
+			if !p.eof() && isDigit(p.peek()) {
+				max, _ = p.decimal()
+			}
+		}
+		if !p.consume("}") {
+			return p.fail(ReasonInvalidSyntax)
+		}
+		if max >= 0 && max < min {
+			p.pos = start
+			return p.fail(ReasonOutOfRange)
+		}
+	default:
+		return true
+	}
+	p.consume("?")
+	return p.noQuantifier()
+}
+
+// decimal reads a non-negative integer, saturating on overflow
+func (p *regexParser) decimal() (int, bool) {
+	start := p.pos
+	n := 0
+	for !p.eof() && isDigit(p.peek()) {
+		if n < 1<<30 {
+			n = n*10 + int(p.peek()-'0')
+		}
+		p.pos++
+	}
+	return n, p.pos > start
+}
+
+// Atom :: PatternCharacter | "." | "\" AtomEscape | CharacterClass | "(" GroupSpecifier? Disjunction ")" | "(?:" Disjunction ")"
+func (p *regexParser) atom() bool {
+	start := p.pos
+	switch c := p.peek(); c {
+	case '.':
+		p.pos++
+		return true
+	case '\\':
+		p.pos++
+		return p.atomEscape()
+	case '[':
+		p.pos++
+		return p.class()
+	case '(':
+		switch {
+		case p.consume("(?:"):
+		case p.consume("(?<"):
+			name, ok := p.groupName()
+			if !ok {
+				return false
+			}
+			if p.names == nil {
+				p.names = make(map[string]bool)
+			}
+			if p.names[name] {
+				p.pos = start
+				return p.fail(ReasonInvalidSyntax)
+			}
+			p.names[name] = true
+			p.groups++
+		case p.consume("(?"):
+			return p.fail(ReasonInvalidSyntax)
+		default:
+			p.pos++
+			p.groups++
+		}
+		return p.group(start)
+	case '*', '+', '?', '{', '}', ']', ')', '|':
+		// a quantifier without atom or a lone syntax character
+		return p.fail(ReasonInvalidCharacter)
+	default:
+		_, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		p.pos += size
+		return true
+	}
+}
+
+// group reads the end of a group opened at start, it fails when groups are
+// nested deeper than maxRegexDepth
+func (p *regexParser) group(start int) bool {
+	if p.depth >= maxRegexDepth {
+		p.pos = start
+		return p.fail(ReasonInvalidSyntax)
+	}
+	p.depth++
+	defer func() { p.depth-- }()
+	if !p.disjunction() {
+		return false
+	}
+	if !p.consume(")") {
+		p.pos = start
+		return p.fail(ReasonInvalidSyntax)
+	}
+	return true
+}
+
+// groupName reads a GroupName after "<": an identifier followed by ">"
+func (p *regexParser) groupName() (string, bool) {
+	start := p.pos
+	for !p.eof() && p.peek() != '>' {
+		r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+		if !(r == '$' || r == '_' || unicode.IsLetter(r) || p.pos > start && (unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Mc, r) || unicode.Is(unicode.Pc, r))) {
+			return "", p.fail(ReasonInvalidCharacter)
+		}
+		p.pos += size
+	}
+	if p.pos == start || p.eof() {
+		return "", p.fail(ReasonInvalidSyntax)
+	}
+	name := p.src[start:p.pos]
+	p.pos++
+	return name, true
+}
+
+// AtomEscape :: DecimalEscape | CharacterClassEscape | CharacterEscape | "k" GroupName
+func (p *regexParser) atomEscape() bool {
+	start := p.pos - 1
+	c := p.peek()
+	switch {
+	case c >= '1' && c <= '9':
+		n, _ := p.decimal()
+		p.refs = append(p.refs, regexBackref{offset: start, group: n})
+		return true
+	case c == 'k':
+		p.pos++
+		if !p.consume("<") {
+			return p.fail(ReasonInvalidSyntax)
+		}
+		name, ok := p.groupName()
+		if !ok {
+			return false
+		}
+		p.refs = append(p.refs, regexBackref{offset: start, name: name})
+		return true
+	}
+	_, ok := p.classEscape(false)
+	return ok
+}
+
+// classEscape reads an escape sequence after "\", in a character class or not.
+// It returns the escaped character, or -1 for a class of characters such as \d.
+func (p *regexParser) classEscape(inClass bool) (rune, bool) {
+	if p.eof() {
+		return 0, p.fail(ReasonInvalidSyntax)
+	}
+	c := p.peek()
+	p.pos++
+	switch c {
+	case 'd', 'D', 's', 'S', 'w', 'W':
+		return -1, true
+	case 'p', 'P':
+		// unicode property: \p{Name} or \p{Name=Value}
+		if !p.consume("{") {
+			return 0, p.fail(ReasonInvalidSyntax)
+		}
+		start := p.pos
+		for !p.eof() && (isLetter(p.peek()) || isDigit(p.peek()) || p.peek() == '_' || p.peek() == '=' && p.pos > start) {
+			p.pos++
+		}
+		if p.pos == start || !p.consume("}") {
+			return 0, p.fail(ReasonInvalidSyntax)
+		}
+		return -1, true
+	case 'f':
+		return '\f', true
+	case 'n':
+		return '\n', true
+	case 'r':
+		return '\r', true
+	case 't':
+		return '\t', true
+	case 'v':
+		return '\v', true
+	case 'b':
+		if inClass {
+			return '\b', true
+		}
+	case '-':
+		if inClass {
+			return '-', true
+		}
+	case 'c':
+		if !p.eof() && isLetter(p.peek()) {
+			p.pos++
+			return rune(p.src[p.pos-1] % 32), true
+		}
+		return 0, p.fail(ReasonInvalidSyntax)
+	case '0':
+		if p.eof() || !isDigit(p.peek()) {
+			return 0, true
+		}
+		return 0, p.fail(ReasonInvalidSyntax)
+	case 'x':
+		return p.hex(2)
+	case 'u':
+		if p.consume("{") {
+			start := p.pos
+			for !p.eof() && isHexDigit(p.peek()) {
+				p.pos++
+			}
+			r, ok := parseHexRune(p.src[start:p.pos])
+			if !ok {
+				p.pos = start
+				return 0, p.fail(ReasonInvalidSyntax)
+			}
+			if !p.consume("}") {
+				return 0, p.fail(ReasonInvalidSyntax)
+			}
+			return r, true
+		}
+		return p.hex(4)
+	default:
+		// identity escapes are limited to the syntax characters and "/"
+		if strings.IndexByte(`^$\.*+?()[]{}|/`, c) >= 0 {
+			return rune(c), true
+		}
+	}
+	p.pos--
+	return 0, p.fail(ReasonInvalidCharacter)
+}
+
+func (p *regexParser) hex(n int) (rune, bool) {
+	if p.pos+n > len(p.src) {
+		return 0, p.fail(ReasonInvalidSyntax)
+	}
+	r, ok := parseHexRune(p.src[p.pos : p.pos+n])
+	if !ok {
+		return 0, p.fail(ReasonInvalidSyntax)
+	}
+	p.pos += n
+	return r, true
+}
+
+// parseHexRune reads a code point written with hexadecimal digits, leading
+// zeros included, it fails beyond unicode.MaxRune
+func parseHexRune(str string) (rune, bool) {
+	if str == "" {
+		return 0, false
+	}
+	var r rune
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case isDigit(c):
+			r = r*16 + rune(c-'0')
+		case 'a' <= c && c <= 'f':
+			r = r*16 + rune(c-'a'+10)
+		case 'A' <= c && c <= 'F':
+			r = r*16 + rune(c-'A'+10)
+		default:
+			return 0, false
+		}
+		if r > unicode.MaxRune {
+			return 0, false
+		}
+	}
+	return r, true
+}
+
+// CharacterClass :: "[" "^"? ClassRanges "]"
+func (p *regexParser) class() bool {
+	start := p.pos - 1
+	p.consume("^")
+	for !p.consume("]") {
+		if p.eof() {
+			p.pos = start
+			return p.fail(ReasonInvalidSyntax)
+		}
+		from := p.pos
+		lo, ok := p.classAtom()
+		if !ok {
+			return false
+		}
+		if p.peek() != '-' || p.pos+1 < len(p.src) && p.src[p.pos+1] == ']' || p.pos+1 >= len(p.src) {
+			continue
+		}
+		p.pos++
+		hi, ok := p.classAtom()
+		if !ok {
+			return false
+		}
+		if lo < 0 || hi < 0 {
+			// a class escape such as \d can't bound a range
+			p.pos = from
+			return p.fail(ReasonInvalidSyntax)
+		}
+		if hi < lo {
+			p.pos = from
+			return p.fail(ReasonOutOfRange)
+		}
+	}
+	return true
+}
+
+func (p *regexParser) classAtom() (rune, bool) {
+	if p.consume(`\`) {
+		return p.classEscape(true)
+	}
+	r, size := utf8.DecodeRuneInString(p.src[p.pos:])
+	p.pos += size
+	return r, true
+}
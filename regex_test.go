@@ -0,0 +1,74 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRegex(t *testing.T) {
+	valid := []string{
+		"",
+		"([abc])+\\s+$",
+		"^[a-z]{2,}?$",
+		"a|b|",
+		"(?:x)(?=y)(?!z)(?<=a)(?<!b)",
+		"(?<year>[0-9]{4})-\\k<year>",
+		"(a)(b)\\2\\1",
+		"[\\d\\-_.]+\\p{L}\\P{Script=Greek}",
+		"\\u{0001F600}\\u{1F600}\\u00e9\\x41\\cM\\0\\/",
+		"[^\\]\\b]",
+		"é+",
+	}
+	for _, str := range valid {
+		assert.NoError(t, ValidateRegex(str), str)
+		assert.True(t, IsRegex(str), str)
+	}
+
+	assertFormatError(t, ValidateRegex("^(abc]"), "regex", 5, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateRegex("(abc"), "regex", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("abc)"), "regex", 3, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateRegex("[abc"), "regex", 0, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("*a"), "regex", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateRegex("a**"), "regex", 2, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("a{2,1}"), "regex", 1, ReasonOutOfRange)
+	assertFormatError(t, ValidateRegex("a{,1}"), "regex", 1, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("[z-a]"), "regex", 1, ReasonOutOfRange)
+	assertFormatError(t, ValidateRegex("[a-\\d]"), "regex", 1, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("(a)\\2"), "regex", 3, ReasonOutOfRange)
+	assertFormatError(t, ValidateRegex("\\k<x>"), "regex", 0, ReasonOutOfRange)
+	assertFormatError(t, ValidateRegex("(?<x>a)(?<x>b)"), "regex", 7, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("(?i)a"), "regex", 2, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("\\q"), "regex", 1, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateRegex("\\u12"), "regex", 2, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("^*"), "regex", 1, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("\\u{FFFFFFFF}"), "regex", 3, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("\\u{110000}"), "regex", 3, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex("\\u{10FFFF"), "regex", 9, ReasonInvalidSyntax)
+}
+
+func TestValidateRegex_depth(t *testing.T) {
+	nested := strings.Repeat("(", maxRegexDepth) + "a" + strings.Repeat(")", maxRegexDepth)
+	assert.NoError(t, ValidateRegex(nested))
+	assert.NoError(t, ValidateRegex(strings.Repeat("(?:a)", 10*maxRegexDepth)))
+
+	// the groups beyond the maximum depth are rejected, even when unbalanced
+	assertFormatError(t, ValidateRegex("("+nested+")"), "regex", maxRegexDepth, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex(strings.Repeat("(?=", 1<<20)), "regex", 3*maxRegexDepth, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateRegex(strings.Repeat("(", 1<<20)), "regex", maxRegexDepth, ReasonInvalidSyntax)
+}
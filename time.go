@@ -16,8 +16,6 @@ package strfmt
 
 import (
 	"database/sql/driver"
-	"errors"
-	"fmt"
 	"regexp"
 	"strings"
 	"time"
@@ -30,26 +28,151 @@ import (
 
 func init() {
 	dt := DateTime{}
-	Default.Add("datetime", &dt, IsDateTime)
+	AddWithInfo(Default, "datetime", &dt, ValidateDateTime, FormatInfo{
+		Description:     "A date-time, as defined by RFC 3339 section 5.6",
+		Pattern:         `^[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])[tT]([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9](\.[0-9]+)?([zZ]|[+-][0-9]{2}:[0-9]{2})$`,
+		Examples:        []string{"2014-12-15T08:00:00.000Z", "2011-08-18T19:03:37+01:00"},
+		InvalidExamples: []string{"2014-12-15", "1972-12-31T24:40:00.000Z"},
+	})
 }
 
 // IsDateTime returns true when the string is a valid date-time
 func IsDateTime(str string) bool {
+	return ValidateDateTime(str) == nil
+}
+
+// ValidateDateTime returns a *FormatError when the string is not a valid date-time
+func ValidateDateTime(str string) error {
 	if len(str) < 4 {
-		return false
+		return newFormatError("datetime", str, len(str), ReasonInvalidLength)
 	}
-	s := strings.Split(strings.ToLower(str), "t")
-	if len(s) < 2 || !IsDate(s[0]) {
-		return false
+	sep := strings.IndexAny(str, "tT")
+	if sep < 0 {
+		return newFormatError("datetime", str, len(str), ReasonInvalidSyntax)
+	}
+	if err := validateDate("datetime", str[:sep]); err != nil {
+		// the date comes first: offsets in the date part are offsets in str
+		fe := err.(*FormatError)
+		fe.Value = str
+		return fe
 	}
 
-	matches := rxDateTime.FindAllStringSubmatch(s[1], -1)
+	start := sep + 1
+	tm := str[start:]
+	if end := strings.IndexAny(tm, "tT"); end >= 0 {
+		tm = tm[:end]
+	}
+	matches := rxDateTime.FindAllStringSubmatch(strings.ToLower(tm), -1)
 	if len(matches) == 0 || len(matches[0]) == 0 {
-		return false
+		return newFormatError("datetime", str, start, ReasonInvalidSyntax)
 	}
 	m := matches[0]
-	res := m[1] <= "23" && m[2] <= "59" && m[3] <= "59"
-	return res
+	switch {
+	case m[1] > "23":
+		return newFormatError("datetime", str, start, ReasonOutOfRange)
+	case m[2] > "59":
+		return newFormatError("datetime", str, start+3, ReasonOutOfRange)
+	case m[3] > "59":
+		return newFormatError("datetime", str, start+6, ReasonOutOfRange)
+	}
+	return nil
+}
+
+// IsTime returns true when the string is a valid full-time
+func IsTime(str string) bool {
+	return ValidateTime(str) == nil
+}
+
+// ValidateTime returns a *FormatError when the string is not a valid full-time,
+// as defined by RFC 3339 section 5.6: a time of day with a time offset.
+//
+// A leap second is only valid at the end of the last minute of a day in UTC.
+func ValidateTime(str string) error {
+	return validateFullTime("time", str, 0)
+}
+
+// validateFullTime checks str[start:] is a full-time:
+//
+//	time-hour ":" time-minute ":" time-second [ "." 1*DIGIT ] ( "Z" / ( "+" / "-" ) time-hour ":" time-minute )
+func validateFullTime(name, str string, start int) error {
+	// the layout of the partial-time is checked first, then the ranges of its fields
+	const layout = "00:00:00"
+	i := start
+	for j := 0; j < len(layout); j, i = j+1, i+1 {
+		if i >= len(str) {
+			return newFormatError(name, str, i, ReasonInvalidLength)
+		}
+		if layout[j] == ':' && str[i] != ':' || layout[j] != ':' && !isDigit(str[i]) {
+			return newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+	}
+	hour, minute, second := twoDigits(str, start), twoDigits(str, start+3), twoDigits(str, start+6)
+	switch {
+	case hour > 23:
+		return newFormatError(name, str, start, ReasonOutOfRange)
+	case minute > 59:
+		return newFormatError(name, str, start+3, ReasonOutOfRange)
+	case second > 60:
+		return newFormatError(name, str, start+6, ReasonOutOfRange)
+	}
+
+	if i < len(str) && str[i] == '.' {
+		i++
+		fraction := i
+		for i < len(str) && isDigit(str[i]) {
+			i++
+		}
+		if i == fraction {
+			return newFormatError(name, str, i, ReasonInvalidSyntax)
+		}
+	}
+
+	if i >= len(str) {
+		return newFormatError(name, str, i, ReasonInvalidLength)
+	}
+	offset := 0
+	switch str[i] {
+	case 'Z', 'z':
+		i++
+	case '+', '-':
+		sign := i
+		i++
+		for j := 0; j < 5; j, i = j+1, i+1 {
+			if i >= len(str) {
+				return newFormatError(name, str, i, ReasonInvalidLength)
+			}
+			if j == 2 && str[i] != ':' || j != 2 && !isDigit(str[i]) {
+				return newFormatError(name, str, i, ReasonInvalidCharacter)
+			}
+		}
+		offsetHour, offsetMinute := twoDigits(str, sign+1), twoDigits(str, sign+4)
+		switch {
+		case offsetHour > 23:
+			return newFormatError(name, str, sign+1, ReasonOutOfRange)
+		case offsetMinute > 59:
+			return newFormatError(name, str, sign+4, ReasonOutOfRange)
+		}
+		offset = offsetHour*60 + offsetMinute
+		if str[sign] == '-' {
+			offset = -offset
+		}
+	default:
+		return newFormatError(name, str, i, ReasonInvalidCharacter)
+	}
+	if i != len(str) {
+		return newFormatError(name, str, i, ReasonInvalidLength)
+	}
+
+	// a leap second happens at 23:59:60 UTC
+	if second == 60 && ((hour*60+minute-offset)%1440+1440)%1440 != 23*60+59 {
+		return newFormatError(name, str, start+6, ReasonOutOfRange)
+	}
+	return nil
+}
+
+// twoDigits returns the value of the 2 digits found at str[i:]
+func twoDigits(str string, i int) int {
+	return int(str[i]-'0')*10 + int(str[i+1]-'0')
 }
 
 const (
@@ -65,25 +188,32 @@ var (
 	dateTimeFormats = []string{RFC3339Micro, RFC3339Millis, time.RFC3339, time.RFC3339Nano}
 	rxDateTime      = regexp.MustCompile(DateTimePattern)
 	// MarshalFormat sets the time resolution format used for marshaling time (set to milliseconds)
+	//
+	// Deprecated: MarshalFormat will be removed in the next release, since it
+	// changes the precision of all the DateTime values of a program. Use
+	// DateTimeSeconds, DateTimeMicro, DateTimeNano or DateTimeFormat for another precision.
 	MarshalFormat = RFC3339Millis
 )
 
-// ParseDateTime parses a string that represents an ISO8601 time or a unix epoch
+// ParseDateTime parses a string that represents an ISO8601 time, with a lenient
+// DateTimeParser. Strings of digits are not unix epochs, which are only read from numbers.
+//
+// The empty string is parsed as the unix epoch.
 func ParseDateTime(data string) (DateTime, error) {
-	if data == "" {
-		return NewDateTime(), nil
-	}
-	var lastError error
-	for _, layout := range dateTimeFormats {
-		dd, err := time.Parse(layout, data)
-		if err != nil {
-			lastError = err
-			continue
-		}
-		lastError = nil
-		return DateTime(dd), nil
+	tt, err := parseDateTime(DateTimeParser{}, data)
+	if err != nil {
+		return DateTime{}, err
 	}
-	return DateTime{}, lastError
+	return DateTime(tt), nil
+}
+
+// parseDateTime parses a string with p, the empty string being the unix epoch
+// unless p is strict
+func parseDateTime(p DateTimeParser, data string) (time.Time, error) {
+	if data == "" && !p.Strict {
+		return p.normalize(time.Time(NewDateTime()), nil)
+	}
+	return p.Parse(data)
 }
 
 // DateTime is a time but it serializes to ISO8601 format with millis
@@ -91,6 +221,11 @@ func ParseDateTime(data string) (DateTime, error) {
 // Most APIs we encounter want either millisecond or second precision times.
 // This just tries to make it worry-free.
 //
+// It serializes with the layout of the deprecated MarshalFormat variable.
+//
+// DateTimeSeconds, DateTimeMicro, DateTimeNano and DateTimeUTC serialize
+// with another precision or in UTC.
+//
 // swagger:strfmt date-time
 type DateTime time.Time
 
@@ -99,6 +234,17 @@ func NewDateTime() DateTime {
 	return DateTime(time.Unix(0, 0).UTC())
 }
 
+// In returns the date-time in the location loc, e.g. the location of a TimeZone,
+// so that it serializes with the offset of loc
+func (t DateTime) In(loc *time.Location) DateTime {
+	return DateTime(time.Time(t).In(loc))
+}
+
+// UTC returns the date-time in UTC
+func (t DateTime) UTC() DateTime {
+	return DateTime(time.Time(t).UTC())
+}
+
 // String converts this time to a string
 func (t DateTime) String() string {
 	return time.Time(t).Format(MarshalFormat)
@@ -111,30 +257,26 @@ func (t DateTime) MarshalText() ([]byte, error) {
 
 // UnmarshalText implements the text unmarshaller interface
 func (t *DateTime) UnmarshalText(text []byte) error {
-	tt, err := ParseDateTime(string(text))
+	return t.parseDateTime(DateTimeParser{}, string(text))
+}
+
+// parseDateTime sets the DateTime from a string parsed with p
+func (t *DateTime) parseDateTime(p DateTimeParser, str string) error {
+	tt, err := parseDateTime(p, str)
 	if err != nil {
 		return err
 	}
-	*t = tt
+	*t = DateTime(tt)
 	return nil
 }
 
 // Scan scans a DateTime value from database driver type.
 func (t *DateTime) Scan(raw interface{}) error {
-	// TODO: case int64: and case float64: ?
-	switch v := raw.(type) {
-	case []byte:
-		return t.UnmarshalText(v)
-	case string:
-		return t.UnmarshalText([]byte(v))
-	case time.Time:
-		*t = DateTime(v)
-	case nil:
-		*t = DateTime{}
-	default:
-		return fmt.Errorf("cannot sql.Scan() strfmt.DateTime from: %#v", v)
+	tt, err := scanDateTime(raw, "DateTime")
+	if err != nil {
+		return err
 	}
-
+	*t = DateTime(tt)
 	return nil
 }
 
@@ -152,25 +294,22 @@ func (t DateTime) MarshalJSON() ([]byte, error) {
 
 // MarshalEasyJSON writes the DateTime to a easyjson.Writer
 func (t DateTime) MarshalEasyJSON(w *jwriter.Writer) {
-	w.String(time.Time(t).Format(MarshalFormat))
+	w.String(t.String())
 }
 
 // UnmarshalJSON sets the DateTime from JSON
 func (t *DateTime) UnmarshalJSON(data []byte) error {
-	l := jlexer.Lexer{Data: data}
-	t.UnmarshalEasyJSON(&l)
-	return l.Error()
+	tt, ok, err := unmarshalDateTimeJSON(data, DateTimeParser{})
+	if ok {
+		*t = DateTime(tt)
+	}
+	return err
 }
 
 // UnmarshalEasyJSON sets the DateTime from a easyjson.Lexer
 func (t *DateTime) UnmarshalEasyJSON(in *jlexer.Lexer) {
-	if data := in.String(); in.Ok() {
-		tt, err := ParseDateTime(data)
-		if err != nil {
-			in.AddError(err)
-			return
-		}
-		*t = tt
+	if tt, ok := readDateTime(in, DateTimeParser{}); ok {
+		*t = DateTime(tt)
 	}
 }
 
@@ -181,16 +320,10 @@ func (t *DateTime) GetBSON() (interface{}, error) {
 
 // SetBSON sets the DateTime from raw bson data
 func (t *DateTime) SetBSON(raw bson.Raw) error {
-	var m bson.M
-	if err := raw.Unmarshal(&m); err != nil {
-		return err
-	}
-
-	if data, ok := m["data"].(string); ok {
-		var err error
-		*t, err = ParseDateTime(data)
+	tt, err := setDateTimeBSON(raw, "DateTime")
+	if err != nil {
 		return err
 	}
-
-	return errors.New("couldn't unmarshal bson raw value as Duration")
+	*t = DateTime(tt)
+	return nil
 }
@@ -0,0 +1,233 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func init() {
+	t := Time{}
+	// register this format in the default registry
+	AddWithInfo(Default, "time", &t, ValidateTime, FormatInfo{
+		Description:     "A full-time, as defined by RFC 3339 section 5.6",
+		Pattern:         `^([01][0-9]|2[0-3]):[0-5][0-9]:([0-5][0-9]|60)(\.[0-9]+)?([zZ]|[+-]([01][0-9]|2[0-3]):[0-5][0-9])$`,
+		Examples:        []string{"08:30:06Z", "23:20:50.52+01:00", "23:59:60Z"},
+		InvalidExamples: []string{"24:00:00Z", "08:30:06", "22:59:60Z"},
+	})
+}
+
+const (
+	// RFC3339FullTime represents a full-time as specified by RFC3339, with nanoseconds when not zero
+	RFC3339FullTime = "15:04:05.999999999Z07:00"
+	// RFC3339PartialTime represents a partial-time as specified by RFC3339, without time offset
+	RFC3339PartialTime = "15:04:05.999999999"
+)
+
+// Time represents a time of day with a time offset, such as 08:30:06Z or 09:00:00+02:00.
+//
+// Only the clock and the location of the underlying time are used: its date is ignored.
+//
+// swagger:strfmt time
+type Time time.Time
+
+// NewTime returns the time of day at the given clock in loc
+func NewTime(hour, min, sec, nsec int, loc *time.Location) Time {
+	return Time(time.Date(0, time.January, 1, hour, min, sec, nsec, loc))
+}
+
+// ParseTime parses a full-time, as defined by RFC 3339 section 5.6.
+//
+// A leap second is read as the last nanosecond of the minute.
+func ParseTime(str string) (Time, error) {
+	if err := ValidateTime(str); err != nil {
+		return Time{}, err
+	}
+	leap := str[6:8] == "60"
+	if leap {
+		str = str[:6] + "59" + str[8:]
+	}
+	tt, err := time.Parse(RFC3339FullTime, strings.ToUpper(str))
+	if err != nil {
+		return Time{}, err
+	}
+	if leap {
+		tt = tt.Add(time.Second - 1 - time.Duration(tt.Nanosecond()))
+	}
+	return Time(tt), nil
+}
+
+// parsePartialTime parses a full-time, or a partial-time without time offset in UTC
+func parsePartialTime(str string) (Time, error) {
+	if tt, err := time.Parse(RFC3339PartialTime, str); err == nil {
+		return Time(tt), nil
+	}
+	return ParseTime(str)
+}
+
+// clock returns the time of day on the same day for all Times, to compare them
+func (t Time) clock() time.Time {
+	tt := time.Time(t)
+	hour, min, sec := tt.Clock()
+	return time.Date(0, time.January, 1, hour, min, sec, tt.Nanosecond(), tt.Location())
+}
+
+// String converts this time of day to a full-time
+func (t Time) String() string {
+	return time.Time(t).Format(RFC3339FullTime)
+}
+
+// Equal reports whether t and u are the same time of day, once their offsets
+// are taken into account: 09:00:00+02:00 is equal to 07:00:00Z.
+func (t Time) Equal(u Time) bool {
+	return t.clock().Equal(u.clock())
+}
+
+// Before reports whether t is before u, as times of the same day
+func (t Time) Before(u Time) bool {
+	return t.clock().Before(u.clock())
+}
+
+// After reports whether t is after u, as times of the same day
+func (t Time) After(u Time) bool {
+	return t.clock().After(u.clock())
+}
+
+// Compare returns -1 if t is before u, +1 if t is after u and 0 if they are equal
+func (t Time) Compare(u Time) int {
+	switch {
+	case t.Before(u):
+		return -1
+	case t.After(u):
+		return +1
+	default:
+		return 0
+	}
+}
+
+// On returns the date-time of this time of day on the date d, in the location of t
+func (t Time) On(d Date) DateTime {
+	tt := time.Time(t)
+	year, month, day := time.Time(d).Date()
+	hour, min, sec := tt.Clock()
+	return DateTime(time.Date(year, month, day, hour, min, sec, tt.Nanosecond(), tt.Location()))
+}
+
+// MarshalText serializes this time of day to a full-time
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText parses a full-time into a time of day
+func (t *Time) UnmarshalText(text []byte) error {
+	tt, err := ParseTime(string(text))
+	if err != nil {
+		return err
+	}
+	*t = tt
+	return nil
+}
+
+// Scan scans a Time value from database driver type.
+//
+// TIME columns without time zone are read in UTC.
+func (t *Time) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		tt, err := parsePartialTime(string(v))
+		if err != nil {
+			return err
+		}
+		*t = tt
+	case string:
+		tt, err := parsePartialTime(v)
+		if err != nil {
+			return err
+		}
+		*t = tt
+	case time.Time:
+		*t = Time(v)
+	case nil:
+		*t = Time{}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Time from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts Time to a primitive value ready to be written to a database.
+func (t Time) Value() (driver.Value, error) {
+	return driver.Value(t.String()), nil
+}
+
+// MarshalJSON returns the Time as JSON
+func (t Time) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	t.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the Time to a easyjson.Writer
+func (t Time) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(t.String())
+}
+
+// UnmarshalJSON sets the Time from JSON
+func (t *Time) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	t.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the Time from a easyjson.Lexer
+func (t *Time) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		tt, err := ParseTime(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*t = tt
+	}
+}
+
+// GetBSON returns the Time as a bson.M{} map.
+func (t *Time) GetBSON() (interface{}, error) {
+	return bson.M{"data": t.String()}, nil
+}
+
+// SetBSON sets the Time from raw bson data
+func (t *Time) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return t.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as Time")
+}
@@ -0,0 +1,141 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTime(t *testing.T) {
+	paris := time.FixedZone("", 3600)
+
+	testCases := []struct {
+		in   string
+		want Time
+		str  string
+	}{
+		{"08:30:06Z", NewTime(8, 30, 6, 0, time.UTC), "08:30:06Z"},
+		{"08:30:06z", NewTime(8, 30, 6, 0, time.UTC), "08:30:06Z"},
+		{"23:20:50.52+01:00", NewTime(23, 20, 50, 520000000, paris), "23:20:50.52+01:00"},
+		{"00:00:00.000000001-05:30", NewTime(0, 0, 0, 1, time.FixedZone("", -19800)), "00:00:00.000000001-05:30"},
+		{"23:59:60Z", NewTime(23, 59, 59, 999999999, time.UTC), "23:59:59.999999999Z"},
+		{"00:59:60.5+01:00", NewTime(0, 59, 59, 999999999, paris), "00:59:59.999999999+01:00"},
+	}
+	for _, tc := range testCases {
+		got, err := ParseTime(tc.in)
+		if assert.NoError(t, err, tc.in) {
+			assert.True(t, tc.want.Equal(got), "%s: expected %v, got %v", tc.in, tc.want, got)
+			assert.Equal(t, tc.str, got.String())
+		}
+	}
+
+	for _, in := range []string{"", "08:30:06", "24:00:00Z", "22:59:60Z", "08:30Z", "2024-01-02T08:30:06Z"} {
+		_, err := ParseTime(in)
+		assert.Error(t, err, in)
+		assert.IsType(t, &FormatError{}, err, in)
+	}
+}
+
+func TestTime_compare(t *testing.T) {
+	nine, err := ParseTime("09:00:00+02:00")
+	assert.NoError(t, err)
+	seven, err := ParseTime("07:00:00Z")
+	assert.NoError(t, err)
+	later, err := ParseTime("07:00:00.5Z")
+	assert.NoError(t, err)
+
+	assert.True(t, nine.Equal(seven))
+	assert.Equal(t, 0, nine.Compare(seven))
+	assert.True(t, nine.Before(later))
+	assert.Equal(t, -1, nine.Compare(later))
+	assert.True(t, later.After(nine))
+	assert.Equal(t, 1, later.Compare(nine))
+
+	// the date of the underlying time is ignored
+	assert.True(t, Time(time.Date(2024, 1, 2, 7, 0, 0, 0, time.UTC)).Equal(seven))
+}
+
+func TestTime_On(t *testing.T) {
+	paris := time.FixedZone("CET", 3600)
+	tm := NewTime(23, 20, 50, 520000000, paris)
+	d := Date(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+
+	dt := tm.On(d)
+	assert.True(t, time.Date(2024, 2, 29, 23, 20, 50, 520000000, paris).Equal(time.Time(dt)))
+	assert.Equal(t, "2024-02-29T23:20:50.520+01:00", dt.String())
+}
+
+func TestTime_marshaling(t *testing.T) {
+	const str = "23:20:50.52+01:00"
+	tm, err := ParseTime(str)
+	assert.NoError(t, err)
+
+	txt, err := tm.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, str, string(txt))
+	var back Time
+	assert.NoError(t, back.UnmarshalText(txt))
+	assert.Equal(t, str, back.String())
+	assert.Error(t, back.UnmarshalText([]byte("23:20:50")))
+
+	js, err := tm.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+str+`"`, string(js))
+	back = Time{}
+	assert.NoError(t, back.UnmarshalJSON(js))
+	assert.Equal(t, str, back.String())
+	assert.Error(t, back.UnmarshalJSON([]byte(`"yada"`)))
+	assert.Equal(t, str, back.String())
+
+	val, err := tm.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, str, val)
+
+	// TIME columns without time zone are read in UTC
+	back = Time{}
+	assert.NoError(t, back.Scan([]byte(str)))
+	assert.Equal(t, str, back.String())
+	assert.NoError(t, back.Scan("08:30:06"))
+	assert.Equal(t, "08:30:06Z", back.String())
+	assert.NoError(t, back.Scan("08:30:06.123456"))
+	assert.Equal(t, "08:30:06.123456Z", back.String())
+	assert.NoError(t, back.Scan(time.Date(0, 1, 1, 8, 30, 6, 0, time.UTC)))
+	assert.Equal(t, "08:30:06Z", back.String())
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, "00:00:00Z", back.String())
+	assert.Error(t, back.Scan("yada"))
+	assert.Error(t, back.Scan(42))
+
+	bsonData, err := bson.Marshal(&tm)
+	assert.NoError(t, err)
+	back = Time{}
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, str, back.String())
+}
+
+func TestTime_registry(t *testing.T) {
+	v, err := Default.Parse("time", "08:30:06Z")
+	assert.NoError(t, err)
+	assert.IsType(t, &Time{}, v)
+	assert.Equal(t, "08:30:06Z", v.(*Time).String())
+
+	_, err = Default.Parse("time", "08:30:06")
+	assert.Error(t, err)
+}
@@ -210,10 +210,10 @@ func TestDateTime_Scan_Failed(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, zero, pp)
 
-	err = pp.Scan(int64(0))
+	err = pp.Scan(true)
 	assert.Error(t, err)
 
-	err = pp.Scan(float64(0))
+	err = pp.Scan([]byte("yada"))
 	assert.Error(t, err)
 }
 
@@ -231,3 +231,24 @@ func TestDateTime_BSON(t *testing.T) {
 		assert.Equal(t, dt, dtCopy)
 	}
 }
+
+func TestValidateTime(t *testing.T) {
+	for _, str := range []string{"08:30:06Z", "08:30:06z", "23:20:50.52Z", "08:30:06-08:00", "23:59:60Z", "01:29:60+01:30", "00:29:60-23:30"} {
+		assert.NoError(t, ValidateTime(str), str)
+		assert.True(t, IsTime(str), str)
+	}
+
+	assertFormatError(t, ValidateTime("24:00:00Z"), "time", 0, ReasonOutOfRange)
+	assertFormatError(t, ValidateTime("00:60:00Z"), "time", 3, ReasonOutOfRange)
+	assertFormatError(t, ValidateTime("00:00:61Z"), "time", 6, ReasonOutOfRange)
+	assertFormatError(t, ValidateTime("22:59:60Z"), "time", 6, ReasonOutOfRange)
+	assertFormatError(t, ValidateTime("23:59:60+01:00"), "time", 6, ReasonOutOfRange)
+	assertFormatError(t, ValidateTime("01:02:03+24:00"), "time", 9, ReasonOutOfRange)
+	assertFormatError(t, ValidateTime("01:02:03+00:60"), "time", 12, ReasonOutOfRange)
+	assertFormatError(t, ValidateTime("01:02:03Z+00:30"), "time", 9, ReasonInvalidLength)
+	assertFormatError(t, ValidateTime("08:30:06 PST"), "time", 8, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateTime("01:01:01,1111"), "time", 8, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateTime("01:01:01.Z"), "time", 9, ReasonInvalidSyntax)
+	assertFormatError(t, ValidateTime("01:01:01"), "time", 8, ReasonInvalidLength)
+	assertFormatError(t, ValidateTime("1:01:01Z"), "time", 1, ReasonInvalidCharacter)
+}
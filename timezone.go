@@ -0,0 +1,212 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func init() {
+	tz := TimeZone("")
+	// register this format in the default registry
+	AddWithInfo(Default, "timezone", &tz, ValidateTimeZone, FormatInfo{
+		Description:     "A time zone name of the IANA time zone database",
+		Examples:        []string{"Europe/Paris", "America/Argentina/Buenos_Aires", "UTC", "Etc/GMT+5"},
+		InvalidExamples: []string{"", "Local", "Europe/Parish", "CET+1", "Europe/Paris "},
+	})
+}
+
+var (
+	// locations caches the loaded time zones, which are read from the
+	// time zone database each time by time.LoadLocation
+	locationsLock sync.RWMutex
+	locations     = make(map[string]*time.Location)
+)
+
+// loadLocation returns the location of a time zone name of the IANA database
+func loadLocation(name string) (*time.Location, error) {
+	locationsLock.RLock()
+	loc, ok := locations[name]
+	locationsLock.RUnlock()
+	if ok {
+		return loc, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locationsLock.Lock()
+	locations[name] = loc
+	locationsLock.Unlock()
+	return loc, nil
+}
+
+// IsTimeZone returns true when the string is a valid time zone name
+func IsTimeZone(str string) bool {
+	return ValidateTimeZone(str) == nil
+}
+
+// ValidateTimeZone returns a *FormatError when the string is not a time zone
+// name of the IANA time zone database, such as "Europe/Paris" or "UTC".
+//
+// The time zone database is embedded when building with Go 1.15 or later,
+// so that validation doesn't depend on the zones installed on the system.
+func ValidateTimeZone(str string) error {
+	const name = "timezone"
+	if str == "" {
+		return newFormatError(name, str, 0, ReasonInvalidLength)
+	}
+	// the names of the database are made of path components
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', isDigit(c), c == '_', c == '-', c == '+':
+		case c == '/' && i > 0 && i < len(str)-1 && str[i-1] != '/':
+		case c == '.' && i > 0 && str[i-1] != '.' && str[i-1] != '/':
+		default:
+			return newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+	}
+	// "Local" is the zone of the system, not a zone of the database
+	if str == "Local" {
+		return newFormatError(name, str, -1, ReasonInvalid)
+	}
+	if _, err := loadLocation(str); err != nil {
+		return newFormatError(name, str, -1, ReasonInvalid)
+	}
+	return nil
+}
+
+// TimeZone represents a time zone name of the IANA time zone database, such as "Europe/Paris"
+//
+// The zero value is written as an empty string, or NULL in a database, and read back from it.
+//
+// swagger:strfmt timezone
+type TimeZone string
+
+// Location returns the location of the time zone, UTC for the empty time zone
+func (tz TimeZone) Location() (*time.Location, error) {
+	if tz == "" {
+		return time.UTC, nil
+	}
+	if err := ValidateTimeZone(string(tz)); err != nil {
+		return nil, err
+	}
+	return loadLocation(string(tz))
+}
+
+// String converts this time zone to a string
+func (tz TimeZone) String() string {
+	return string(tz)
+}
+
+// MarshalText turns this instance into text
+func (tz TimeZone) MarshalText() ([]byte, error) {
+	return []byte(tz), nil
+}
+
+// UnmarshalText hydrates this instance from text, the empty text into the zero value
+func (tz *TimeZone) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*tz = ""
+		return nil
+	}
+	if err := ValidateTimeZone(string(data)); err != nil {
+		return err
+	}
+	*tz = TimeZone(data)
+	return nil
+}
+
+// Scan reads a TimeZone value from database driver type.
+func (tz *TimeZone) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return tz.UnmarshalText(v)
+	case string:
+		return tz.UnmarshalText([]byte(v))
+	case nil:
+		*tz = TimeZone("")
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.TimeZone from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts TimeZone to a primitive value ready to be written to a database, the zero value to NULL.
+func (tz TimeZone) Value() (driver.Value, error) {
+	if tz == "" {
+		return nil, nil
+	}
+	return driver.Value(string(tz)), nil
+}
+
+// MarshalJSON returns the TimeZone as JSON
+func (tz TimeZone) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	tz.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the TimeZone to a easyjson.Writer
+func (tz TimeZone) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(string(tz))
+}
+
+// UnmarshalJSON sets the TimeZone from JSON
+func (tz *TimeZone) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	tz.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the TimeZone from a easyjson.Lexer
+func (tz *TimeZone) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		if err := tz.UnmarshalText([]byte(data)); err != nil {
+			in.AddError(err)
+		}
+	}
+}
+
+// GetBSON returns the TimeZone as a bson.M{} map.
+func (tz *TimeZone) GetBSON() (interface{}, error) {
+	return bson.M{"data": string(*tz)}, nil
+}
+
+// SetBSON sets the TimeZone from raw bson data
+func (tz *TimeZone) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return tz.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as TimeZone")
+}
@@ -0,0 +1,211 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTimeZone(t *testing.T) {
+	for _, str := range []string{"Europe/Paris", "America/Argentina/Buenos_Aires", "UTC", "Etc/GMT+5", "Etc/GMT-14", "America/Port-au-Prince"} {
+		assert.NoError(t, ValidateTimeZone(str), str)
+		assert.True(t, IsTimeZone(str), str)
+	}
+
+	assertFormatError(t, ValidateTimeZone(""), "timezone", 0, ReasonInvalidLength)
+	assertFormatError(t, ValidateTimeZone("Local"), "timezone", -1, ReasonInvalid)
+	assertFormatError(t, ValidateTimeZone("Europe/Parish"), "timezone", -1, ReasonInvalid)
+	assertFormatError(t, ValidateTimeZone("Europe/Paris "), "timezone", 12, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateTimeZone("/etc/passwd"), "timezone", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateTimeZone("Europe//Paris"), "timezone", 7, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateTimeZone("Europe/../Paris"), "timezone", 7, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateTimeZone("Europe/"), "timezone", 6, ReasonInvalidCharacter)
+}
+
+func TestTimeZone(t *testing.T) {
+	tz := TimeZone("Europe/Paris")
+	loc, err := tz.Location()
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Paris", loc.String())
+	// daylight saving time comes from the database
+	_, offset := time.Date(2024, 7, 1, 0, 0, 0, 0, loc).Zone()
+	assert.Equal(t, 2*3600, offset)
+
+	loc, err = TimeZone("").Location()
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+	_, err = TimeZone("Mars/Olympus_Mons").Location()
+	assert.Error(t, err)
+
+	txt, err := tz.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Paris", string(txt))
+	var back TimeZone
+	assert.NoError(t, back.UnmarshalText(txt))
+	assert.Equal(t, tz, back)
+	assert.Error(t, back.UnmarshalText([]byte("Local")))
+	assert.Equal(t, tz, back)
+
+	js, err := tz.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"Europe/Paris"`, string(js))
+	back = ""
+	assert.NoError(t, back.UnmarshalJSON(js))
+	assert.Equal(t, tz, back)
+	assert.Error(t, back.UnmarshalJSON([]byte(`"Europe/Parish"`)))
+
+	val, err := tz.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Paris", val)
+	back = ""
+	assert.NoError(t, back.Scan([]byte("Europe/Paris")))
+	assert.Equal(t, tz, back)
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, TimeZone(""), back)
+	assert.Error(t, back.Scan("yada"))
+	assert.Error(t, back.Scan(42))
+
+	bsonData, err := bson.Marshal(&tz)
+	assert.NoError(t, err)
+	back = ""
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, tz, back)
+
+	v, err := Default.Parse("timezone", "Asia/Tokyo")
+	assert.NoError(t, err)
+	assert.Equal(t, TimeZone("Asia/Tokyo"), *v.(*TimeZone))
+}
+
+func TestTimeZone_zero(t *testing.T) {
+	type schedule struct {
+		Zone TimeZone `json:"zone"`
+	}
+	js, err := json.Marshal(schedule{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"zone":""}`, string(js))
+	back := schedule{Zone: "Europe/Paris"}
+	assert.NoError(t, json.Unmarshal(js, &back))
+	assert.Equal(t, schedule{}, back)
+
+	var zero TimeZone
+	back.Zone = "Europe/Paris"
+	assert.NoError(t, back.Zone.UnmarshalText(nil))
+	assert.Equal(t, zero, back.Zone)
+
+	val, err := zero.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+	back.Zone = "Europe/Paris"
+	assert.NoError(t, back.Zone.Scan(""))
+	assert.Equal(t, zero, back.Zone)
+
+	bsonData, err := bson.Marshal(&zero)
+	assert.NoError(t, err)
+	back.Zone = "Europe/Paris"
+	assert.NoError(t, bson.Unmarshal(bsonData, &back.Zone))
+	assert.Equal(t, zero, back.Zone)
+
+	// the empty string isn't a valid time zone though
+	assert.Error(t, ValidateTimeZone(""))
+}
+
+func TestDateTime_normalize(t *testing.T) {
+	loc, err := TimeZone("America/New_York").Location()
+	assert.NoError(t, err)
+
+	// a parser may normalize date-times to a location
+	tt, err := DateTimeParser{Normalize: time.UTC}.Parse("2024-01-02T15:04:05+01:00")
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 1, 2, 14, 4, 5, 0, time.UTC), tt)
+	tt, err = DateTimeParser{Normalize: loc}.Parse("2024-01-02T15:04:05+01:00")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-02T09:04:05.000-05:00", DateTime(tt).String())
+	tt, err = DateTimeParser{Normalize: loc}.ParseEpoch(0)
+	assert.NoError(t, err)
+	assert.Equal(t, loc, tt.Location())
+	_, err = DateTimeParser{Normalize: loc}.Parse("yada")
+	assert.Error(t, err)
+
+	dt, err := ParseDateTime("2024-01-02T15:04:05+01:00")
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-01-02T14:04:05.000Z", dt.UTC().String())
+	assert.Equal(t, "2024-01-02T09:04:05.000-05:00", dt.In(loc).String())
+
+	// DateTimeUTC values read with different offsets are equal
+	var a, b DateTimeUTC
+	assert.NoError(t, a.UnmarshalJSON([]byte(`"2024-01-02T15:04:05+01:00"`)))
+	assert.NoError(t, b.UnmarshalText([]byte("2024-01-02T09:04:05-05:00")))
+	assert.Equal(t, a, b)
+	assert.NoError(t, b.Scan(time.Date(2024, 1, 2, 9, 4, 5, 0, loc)))
+	assert.Equal(t, a, b)
+	assert.Equal(t, time.UTC, time.Time(b).Location())
+}
+
+func TestDateTime_normalizeOnUnmarshal(t *testing.T) {
+	loc, err := TimeZone("America/New_York").Location()
+	assert.NoError(t, err)
+
+	// DateTimeUTC fields are normalized to UTC by json.Unmarshal and the hooks
+	var u struct {
+		At  DateTimeUTC  `json:"at"`
+		Ref *DateTimeUTC `json:"ref"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"at":"2024-01-02T15:04:05+01:00","ref":"2024-01-02T09:04:05-05:00"}`), &u))
+	if assert.NotNil(t, u.Ref) {
+		assert.Equal(t, "2024-01-02T14:04:05Z", time.Time(u.At).Format(time.RFC3339))
+		assert.Equal(t, time.UTC, time.Time(u.At).Location())
+		assert.Equal(t, u.At, *u.Ref)
+	}
+	utc := NewFormats().(*defaultFormats)
+	utc.AddE("date-time", &DateTimeUTC{}, ValidateDateTime)
+	u.Ref = nil
+	assert.NoError(t, decodeWith(utc.MapStructureHookFunc(), map[string]interface{}{"ref": "2024-01-02T15:04:05+01:00"}, &u))
+	if assert.NotNil(t, u.Ref) {
+		assert.Equal(t, u.At, *u.Ref)
+	}
+
+	// a registry normalizes date-times to a location with Parse and the hooks
+	registry := NewFormats().(*defaultFormats)
+	assert.True(t, registry.UseDateTimeParser("date-time", DateTimeParser{Normalize: loc}))
+	var m struct {
+		At  DateTime
+		Ref *DateTime
+	}
+	in := map[string]interface{}{"at": "2024-01-02T15:04:05+01:00", "ref": "2024-01-02T14:04:05Z"}
+	for _, hook := range []mapstructure.DecodeHookFunc{registry.MapStructureHookFunc(), registry.ValidatingMapStructureHookFunc()} {
+		m.Ref = nil
+		assert.NoError(t, decodeWith(hook, in, &m))
+		assert.Equal(t, "2024-01-02T09:04:05.000-05:00", m.At.String())
+		assert.Equal(t, loc, time.Time(m.At).Location())
+		if assert.NotNil(t, m.Ref) {
+			assert.Equal(t, m.At, *m.Ref)
+		}
+	}
+	v, err := registry.Parse("date-time", "2024-01-02T15:04:05+01:00")
+	assert.NoError(t, err)
+	assert.Equal(t, m.At, *v.(*DateTime))
+
+	// json.Unmarshal doesn't go through a registry and preserves the offset
+	var dt DateTime
+	assert.NoError(t, json.Unmarshal([]byte(`"2024-01-02T15:04:05+01:00"`), &dt))
+	assert.Equal(t, "2024-01-02T15:04:05.000+01:00", dt.String())
+}
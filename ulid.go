@@ -0,0 +1,192 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// crockfordAlphabet are the digits of the Crockford base32 encoding of ULIDs,
+// which leaves out I, L, O and U
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// crockfordDigit returns the value of a Crockford base32 digit in any case, -1 for other characters
+func crockfordDigit(c byte) int {
+	if 'a' <= c && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	return strings.IndexByte(crockfordAlphabet, c)
+}
+
+// IsULID returns true when the string is a valid ULID
+func IsULID(str string) bool {
+	return ValidateULID(str) == nil
+}
+
+// ValidateULID returns a *FormatError when the string is not a ULID: 26 digits
+// of the Crockford base32 encoding, in upper or lower case, up to 7ZZZZZZZZZZZZZZZZZZZZZZZZZ
+func ValidateULID(str string) error {
+	_, err := decodeBase("ulid", str, 32, 26, 16, crockfordDigit)
+	return err
+}
+
+// decodeBase decodes a number written with n digits in base into size big
+// endian bytes, it fails when the number doesn't fit
+func decodeBase(name, str string, base, n, size int, digit func(byte) int) ([]byte, error) {
+	b := make([]byte, size)
+	for i := 0; i < n; i++ {
+		if i >= len(str) {
+			return nil, newFormatError(name, str, i, ReasonInvalidLength)
+		}
+		d := digit(str[i])
+		if d < 0 {
+			return nil, newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+		carry := d
+		for j := size - 1; j >= 0; j-- {
+			carry += int(b[j]) * base
+			b[j] = byte(carry)
+			carry >>= 8
+		}
+		if carry != 0 {
+			return nil, newFormatError(name, str, 0, ReasonOutOfRange)
+		}
+	}
+	if len(str) > n {
+		return nil, newFormatError(name, str, n, ReasonInvalidLength)
+	}
+	return b, nil
+}
+
+// encodeBase writes the big endian bytes b with n digits of alphabet
+func encodeBase(b []byte, alphabet string, n int) string {
+	base := len(alphabet)
+	num := append([]byte(nil), b...)
+	digits := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		rem := 0
+		for j := range num {
+			rem = rem<<8 | int(num[j])
+			num[j] = byte(rem / base)
+			rem %= base
+		}
+		digits[i] = alphabet[rem]
+	}
+	return string(digits)
+}
+
+// monotonicGenerator generates identifiers made of a timestamp and random bytes
+// which are strictly increasing: within the same timestamp, the random bytes of
+// the previous identifier are incremented
+type monotonicGenerator struct {
+	sync.Mutex
+	now  func() time.Time
+	unit time.Duration
+	// ts and entropy are the timestamp in units and the random bytes of the last identifier
+	ts      int64
+	entropy []byte
+}
+
+// next returns the timestamp and the random bytes of the next identifier, it
+// panics when the random generator of the system fails
+func (g *monotonicGenerator) next(size int) (int64, []byte) {
+	g.Lock()
+	defer g.Unlock()
+	ts := g.now().UnixNano() / int64(g.unit)
+	switch {
+	case ts > g.ts || g.entropy == nil:
+		g.ts = ts
+		g.entropy = make([]byte, size)
+		if _, err := io.ReadFull(rand.Reader, g.entropy); err != nil {
+			panic("strfmt: can't generate a random identifier: " + err.Error())
+		}
+	case !increment(g.entropy):
+		// the random bytes overflowed, or the clock goes backwards
+		g.ts++
+	}
+	return g.ts, append([]byte(nil), g.entropy...)
+}
+
+// increment adds 1 to the big endian bytes b, it returns false when they overflow to 0
+func increment(b []byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+var ulids = &monotonicGenerator{now: time.Now, unit: time.Millisecond}
+
+// NewULID returns a new ULID, made of the current Unix time in milliseconds and
+// of 80 random bits. It panics when the random generator of the system fails.
+//
+// The ULIDs generated by a process are strictly increasing: the random bits
+// are incremented for the ULIDs generated within the same millisecond.
+func NewULID() ULID {
+	return newULID(ulids)
+}
+
+func newULID(g *monotonicGenerator) ULID {
+	ms, entropy := g.next(10)
+	b := append([]byte{byte(ms >> 40), byte(ms >> 32), byte(ms >> 24), byte(ms >> 16), byte(ms >> 8), byte(ms)}, entropy...)
+	return ULID(encodeBase(b, crockfordAlphabet, 26))
+}
+
+// Bytes returns the 16 bytes of the ULID, all zero when it isn't a valid ULID
+func (u ULID) Bytes() [16]byte {
+	var b [16]byte
+	if d, err := decodeBase("ulid", string(u), 32, 26, 16, crockfordDigit); err == nil {
+		copy(b[:], d)
+	}
+	return b
+}
+
+// Canonical returns the ULID in upper case, unchanged when it isn't a valid ULID
+func (u ULID) Canonical() ULID {
+	if ValidateULID(string(u)) != nil {
+		return u
+	}
+	return ULID(strings.ToUpper(string(u)))
+}
+
+func (u *ULID) canonicalize() {
+	*u = u.Canonical()
+}
+
+// Time returns the time the ULID was generated at, to the millisecond in UTC,
+// the zero time when it isn't a valid ULID
+func (u ULID) Time() time.Time {
+	if ValidateULID(string(u)) != nil {
+		return time.Time{}
+	}
+	b := u.Bytes()
+	ms := int64(b[0])<<40 | int64(b[1])<<32 | int64(b[2])<<24 | int64(b[3])<<16 | int64(b[4])<<8 | int64(b[5])
+	return time.Unix(ms/1000, ms%1000*int64(time.Millisecond)).UTC()
+}
+
+// Compare returns -1 if u sorts before o, +1 if u sorts after o and 0 if they
+// are the same ULID, regardless of their case
+func (u ULID) Compare(o ULID) int {
+	ub, ob := u.Bytes(), o.Bytes()
+	return bytes.Compare(ub[:], ob[:])
+}
@@ -0,0 +1,90 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateULID_errors(t *testing.T) {
+	assertFormatError(t, ValidateULID(""), "ulid", 0, ReasonInvalidLength)
+	assertFormatError(t, ValidateULID("01ARZ3NDEKTSV4RRFFQ69G5FA"), "ulid", 25, ReasonInvalidLength)
+	assertFormatError(t, ValidateULID("01ARZ3NDEKTSV4RRFFQ69G5FAVX"), "ulid", 26, ReasonInvalidLength)
+	assertFormatError(t, ValidateULID("01ARZ3NDEKTSV4RRFFQ69G5FAU"), "ulid", 25, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateULID("01ARZ3NDEKTSV4-RFFQ69G5FAV"), "ulid", 14, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateULID("81ARZ3NDEKTSV4RRFFQ69G5FAV"), "ulid", 0, ReasonOutOfRange)
+}
+
+func TestULID(t *testing.T) {
+	u := ULID("01arz3ndektsv4rrffq69g5fav")
+	assert.Equal(t, [16]byte{0x01, 0x56, 0x3e, 0x3a, 0xb5, 0xd3, 0xd6, 0x76, 0x4c, 0x61, 0xef, 0xb9, 0x93, 0x02, 0xbd, 0x5b}, u.Bytes())
+	assert.Equal(t, time.Date(2016, time.July, 30, 23, 54, 10, 259000000, time.UTC), u.Time())
+	assert.Equal(t, ULID("01ARZ3NDEKTSV4RRFFQ69G5FAV"), u.Canonical())
+
+	assert.Equal(t, 0, u.Compare("01ARZ3NDEKTSV4RRFFQ69G5FAV"))
+	assert.Equal(t, -1, u.Compare("01ARZ3NDEKTSV4RRFFQ69G5FAW"))
+	assert.Equal(t, +1, u.Compare("01ARZ3NDEKTSV4RRFFQ69G5FA0"))
+
+	assert.Equal(t, [16]byte{}, ULID("not a ulid").Bytes())
+	assert.Equal(t, ULID("not a ulid"), ULID("not a ulid").Canonical())
+	assert.True(t, ULID("not a ulid").Time().IsZero())
+}
+
+func TestNewULID(t *testing.T) {
+	u := NewULID()
+	assert.NoError(t, ValidateULID(string(u)))
+	assert.Equal(t, u, u.Canonical())
+	assert.WithinDuration(t, time.Now(), u.Time(), time.Second)
+	assert.Equal(t, -1, u.Compare(NewULID()))
+}
+
+func TestULIDGenerator_monotonic(t *testing.T) {
+	now := time.Date(2016, time.July, 30, 23, 54, 10, 259000000, time.UTC)
+	g := &monotonicGenerator{now: func() time.Time { return now }, unit: time.Millisecond}
+
+	// within the same millisecond, then the clock goes backwards
+	ulids := make([]string, 0, 200)
+	for i := 0; i < 100; i++ {
+		ulids = append(ulids, string(newULID(g)))
+	}
+	now = now.Add(-time.Second)
+	for i := 0; i < 100; i++ {
+		ulids = append(ulids, string(newULID(g)))
+	}
+
+	assert.True(t, sort.StringsAreSorted(ulids))
+	for i := 1; i < len(ulids); i++ {
+		assert.Equal(t, -1, ULID(ulids[i-1]).Compare(ULID(ulids[i])))
+		assert.Equal(t, now.Add(time.Second), ULID(ulids[i]).Time())
+	}
+
+	// the random bits overflow into the next millisecond
+	g.entropy = []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	u := newULID(g)
+	assert.Equal(t, now.Add(time.Second+time.Millisecond), u.Time())
+	assert.Equal(t, -1, ULID(ulids[len(ulids)-1]).Compare(u))
+}
+
+func TestEncodeBase(t *testing.T) {
+	for _, str := range []string{"00000000000000000000000000", "01ARZ3NDEKTSV4RRFFQ69G5FAV", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ"} {
+		b, err := decodeBase("ulid", str, 32, 26, 16, crockfordDigit)
+		assert.NoError(t, err)
+		assert.Equal(t, str, encodeBase(b, crockfordAlphabet, 26))
+	}
+}
@@ -0,0 +1,362 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"net"
+	"strings"
+	"unicode/utf8"
+)
+
+// IsURIReference returns true when the string is a valid URI reference
+func IsURIReference(str string) bool {
+	return ValidateURIReference(str) == nil
+}
+
+// ValidateURIReference returns a *FormatError when the string is not a URI
+// or a relative reference, as defined by RFC 3986 section 4.1
+func ValidateURIReference(str string) error {
+	return validateURI("uri-reference", str, false, false)
+}
+
+// IsIRI returns true when the string is a valid IRI
+func IsIRI(str string) bool {
+	return ValidateIRI(str) == nil
+}
+
+// ValidateIRI returns a *FormatError when the string is not an absolute IRI, as defined by RFC 3987
+func ValidateIRI(str string) error {
+	return validateURI("iri", str, true, true)
+}
+
+// IsIRIReference returns true when the string is a valid IRI reference
+func IsIRIReference(str string) bool {
+	return ValidateIRIReference(str) == nil
+}
+
+// ValidateIRIReference returns a *FormatError when the string is not an IRI
+// or a relative IRI reference, as defined by RFC 3987
+func ValidateIRIReference(str string) error {
+	return validateURI("iri-reference", str, true, false)
+}
+
+// validateURI checks str against the URI-reference grammar of RFC 3986.
+// When iri is true, the non-ASCII characters allowed by RFC 3987 are accepted.
+// When absolute is true, the reference must have a scheme.
+func validateURI(name, str string, iri, absolute bool) error {
+	i := 0
+	end := strings.IndexAny(str, ":/?#")
+	switch {
+	case end >= 0 && str[end] == ':':
+		// scheme = ALPHA *( ALPHA / DIGIT / "+" / "-" / "." )
+		// a relative reference can't have a colon in its first segment either
+		for j := 0; j < end; j++ {
+			c := str[j]
+			if !isLetter(c) && (j == 0 || !isDigit(c) && c != '+' && c != '-' && c != '.') {
+				return newFormatError(name, str, j, ReasonInvalidCharacter)
+			}
+		}
+		if end == 0 {
+			return newFormatError(name, str, 0, ReasonInvalidSyntax)
+		}
+		i = end + 1
+	case absolute:
+		// the scheme is missing
+		if end < 0 {
+			end = len(str)
+		}
+		return newFormatError(name, str, end, ReasonInvalidSyntax)
+	}
+
+	if strings.HasPrefix(str[i:], "//") {
+		start := i + 2
+		i = len(str)
+		if j := strings.IndexAny(str[start:], "/?#"); j >= 0 {
+			i = start + j
+		}
+		if err := validateAuthority(name, str, start, i, iri); err != nil {
+			return err
+		}
+	}
+
+	// path, query and fragment
+	parts := []struct {
+		prefix  byte
+		stop    string
+		allowed string
+		private bool
+	}{
+		{0, "?#", ":@/", false},
+		{'?', "#", ":@/?", true},
+		{'#', "", ":@/?", false},
+	}
+	for _, part := range parts {
+		if part.prefix != 0 {
+			if i >= len(str) || str[i] != part.prefix {
+				continue
+			}
+			i++
+		}
+		end := len(str)
+		if j := strings.IndexAny(str[i:], part.stop); j >= 0 {
+			end = i + j
+		}
+		if bad := checkURIChars(str, i, end, part.allowed, iri, part.private); bad >= 0 {
+			return newFormatError(name, str, bad, ReasonInvalidCharacter)
+		}
+		i = end
+	}
+	return nil
+}
+
+// validateAuthority checks the authority str[start:end]: [ userinfo "@" ] host [ ":" port ]
+func validateAuthority(name, str string, start, end int, iri bool) error {
+	host := start
+	if at := strings.IndexByte(str[start:end], '@'); at >= 0 {
+		if bad := checkURIChars(str, start, start+at, ":", iri, false); bad >= 0 {
+			return newFormatError(name, str, bad, ReasonInvalidCharacter)
+		}
+		host = start + at + 1
+	}
+
+	port := -1
+	if host < end && str[host] == '[' {
+		closing := strings.IndexByte(str[host:end], ']')
+		if closing < 0 {
+			return newFormatError(name, str, end, ReasonInvalidSyntax)
+		}
+		closing += host
+		if !isIPLiteral(str[host+1 : closing]) {
+			return newFormatError(name, str, host+1, ReasonInvalidSyntax)
+		}
+		if closing+1 < end {
+			if str[closing+1] != ':' {
+				return newFormatError(name, str, closing+1, ReasonInvalidCharacter)
+			}
+			port = closing + 2
+		}
+	} else {
+		hostEnd := end
+		if colon := strings.IndexByte(str[host:end], ':'); colon >= 0 {
+			hostEnd = host + colon
+			port = hostEnd + 1
+		}
+		if bad := checkURIChars(str, host, hostEnd, "", iri, false); bad >= 0 {
+			return newFormatError(name, str, bad, ReasonInvalidCharacter)
+		}
+	}
+
+	if port >= 0 {
+		for j := port; j < end; j++ {
+			if !isDigit(str[j]) {
+				return newFormatError(name, str, j, ReasonInvalidCharacter)
+			}
+		}
+	}
+	return nil
+}
+
+// isIPLiteral checks the content of an IP-literal: an IPv6 address or an IPvFuture
+func isIPLiteral(str string) bool {
+	if len(str) > 0 && (str[0] == 'v' || str[0] == 'V') {
+		// IPvFuture = "v" 1*HEXDIG "." 1*( unreserved / sub-delims / ":" )
+		dot := strings.IndexByte(str, '.')
+		if dot < 2 || dot == len(str)-1 {
+			return false
+		}
+		for j := 1; j < dot; j++ {
+			if !isHexDigit(str[j]) {
+				return false
+			}
+		}
+		for j := dot + 1; j < len(str); j++ {
+			if c := str[j]; !isUnreserved(c) && !isSubDelim(c) && c != ':' {
+				return false
+			}
+		}
+		return true
+	}
+	return strings.IndexByte(str, ':') >= 0 && net.ParseIP(str) != nil
+}
+
+// checkURIChars returns the offset of the first character of str[start:end]
+// which is neither unreserved, a sub-delim, a percent-encoded octet nor one of
+// allowed, or -1 when they are all valid.
+//
+// When iri is true, the ucschar of RFC 3987 are accepted, as well as the
+// iprivate characters when private is true.
+func checkURIChars(str string, start, end int, allowed string, iri, private bool) int {
+	for i := start; i < end; {
+		c := str[i]
+		switch {
+		case c >= utf8.RuneSelf:
+			r, size := utf8.DecodeRuneInString(str[i:end])
+			if !iri || r == utf8.RuneError && size == 1 || !isUCSChar(r) && !(private && isIPrivate(r)) {
+				return i
+			}
+			i += size
+			continue
+		case c == '%':
+			if i+2 >= end || !isHexDigit(str[i+1]) || !isHexDigit(str[i+2]) {
+				return i
+			}
+			i += 3
+			continue
+		case isUnreserved(c) || isSubDelim(c) || strings.IndexByte(allowed, c) >= 0:
+		default:
+			return i
+		}
+		i++
+	}
+	return -1
+}
+
+// unreserved = ALPHA / DIGIT / "-" / "." / "_" / "~"
+func isUnreserved(c byte) bool {
+	return isLetter(c) || isDigit(c) || c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+// sub-delims = "!" / "$" / "&" / "'" / "(" / ")" / "*" / "+" / "," / ";" / "="
+func isSubDelim(c byte) bool {
+	return strings.IndexByte("!$&'()*+,;=", c) >= 0
+}
+
+// isUCSChar reports whether r is an ucschar of RFC 3987
+func isUCSChar(r rune) bool {
+	switch {
+	case r < 0xA0:
+		return false
+	case r <= 0xD7FF:
+		return true
+	case r < 0xF900:
+		return false
+	case r <= 0xFDCF:
+		return true
+	case r < 0xFDF0:
+		return false
+	case r <= 0xFFEF:
+		return true
+	case r < 0x10000 || r > 0xEFFFD:
+		return false
+	case r >= 0xE0000 && r < 0xE1000:
+		return false
+	}
+	// the last two code points of each plane are not characters
+	return r&0xFFFF <= 0xFFFD
+}
+
+// isIPrivate reports whether r is an iprivate character of RFC 3987
+func isIPrivate(r rune) bool {
+	return r >= 0xE000 && r <= 0xF8FF ||
+		r >= 0xF0000 && r <= 0xFFFFD ||
+		r >= 0x100000 && r <= 0x10FFFD
+}
+
+// IsURITemplate returns true when the string is a valid URI template
+func IsURITemplate(str string) bool {
+	return ValidateURITemplate(str) == nil
+}
+
+// ValidateURITemplate returns a *FormatError when the string is not a URI template, as defined by RFC 6570
+func ValidateURITemplate(str string) error {
+	const name = "uri-template"
+	for i := 0; i < len(str); {
+		c := str[i]
+		switch {
+		case c == '{':
+			closing := strings.IndexByte(str[i:], '}')
+			if closing < 0 {
+				return newFormatError(name, str, len(str), ReasonInvalidSyntax)
+			}
+			if bad := checkTemplateExpression(str, i+1, i+closing); bad >= 0 {
+				return newFormatError(name, str, bad, ReasonInvalidCharacter)
+			}
+			i += closing + 1
+			continue
+		case c >= utf8.RuneSelf:
+			r, size := utf8.DecodeRuneInString(str[i:])
+			if r == utf8.RuneError && size == 1 || !isUCSChar(r) && !isIPrivate(r) {
+				return newFormatError(name, str, i, ReasonInvalidCharacter)
+			}
+			i += size
+			continue
+		case c == '%':
+			if i+2 >= len(str) || !isHexDigit(str[i+1]) || !isHexDigit(str[i+2]) {
+				return newFormatError(name, str, i, ReasonInvalidCharacter)
+			}
+			i += 3
+			continue
+		case c <= ' ' || c == 0x7f || strings.IndexByte("\"'<>\\^`|}", c) >= 0:
+			return newFormatError(name, str, i, ReasonInvalidCharacter)
+		}
+		i++
+	}
+	return nil
+}
+
+// checkTemplateExpression returns the offset of the first invalid character of
+// the expression str[start:end] found between braces, or -1 when it is valid:
+//
+//	expression = "{" [ operator ] variable-list "}"
+//	variable-list = varspec *( "," varspec )
+//	varspec = varname [ ":" max-length / "*" ]
+//	varname = varchar *( ["."] varchar )
+func checkTemplateExpression(str string, start, end int) int {
+	i := start
+	if i < end && strings.IndexByte("+#./;?&", str[i]) >= 0 {
+		i++
+	}
+	for {
+		// varname
+		first := i
+	varname:
+		for i < end {
+			c := str[i]
+			switch {
+			case isLetter(c) || isDigit(c) || c == '_':
+				i++
+			case c == '%' && i+2 < end && isHexDigit(str[i+1]) && isHexDigit(str[i+2]):
+				i += 3
+			case c == '.' && i > first && str[i-1] != '.':
+				i++
+			default:
+				break varname
+			}
+		}
+		if i == first || str[i-1] == '.' {
+			return i
+		}
+		if i < end && str[i] == '*' {
+			i++
+		} else if i < end && str[i] == ':' {
+			// max-length = %x31-39 0*3DIGIT
+			i++
+			digits := i
+			for i < end && isDigit(str[i]) && i-digits < 4 {
+				i++
+			}
+			if i == digits || str[digits] == '0' {
+				return digits
+			}
+		}
+		if i == end {
+			return -1
+		}
+		if str[i] != ',' {
+			return i
+		}
+		i++
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// The namespaces of name based UUIDs defined by RFC 4122 appendix C
+const (
+	NamespaceDNS  = UUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceURL  = UUID("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceOID  = UUID("6ba7b812-9dad-11d1-80b4-00c04fd430c8")
+	NamespaceX500 = UUID("6ba7b814-9dad-11d1-80b4-00c04fd430c8")
+)
+
+// UUIDVariant is the layout of a UUID, as defined by RFC 4122 section 4.1.1
+type UUIDVariant int
+
+const (
+	// VariantNCS is reserved for backward compatibility with the Apollo NCS
+	VariantNCS UUIDVariant = iota
+	// VariantRFC4122 is the layout of the UUIDs defined by RFC 4122
+	VariantRFC4122
+	// VariantMicrosoft is reserved for backward compatibility with Microsoft GUIDs
+	VariantMicrosoft
+	// VariantFuture is reserved for future definition
+	VariantFuture
+)
+
+// uuidBytes returns the 16 bytes of a UUID, or false when str isn't a UUID
+func uuidBytes(str string) ([16]byte, bool) {
+	var b [16]byte
+	if validateUUID("uuid", str, 0, false) != nil {
+		return b, false
+	}
+	j := 0
+	for i := range b {
+		if str[j] == '-' {
+			j++
+		}
+		// validated above, the digits can't be invalid
+		_, _ = hex.Decode(b[i:i+1], []byte(str[j:j+2]))
+		j += 2
+	}
+	return b, true
+}
+
+// formatUUID writes the bytes of a UUID in lower case with dashes
+func formatUUID(b [16]byte) string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], b[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], b[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], b[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], b[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], b[10:])
+	return string(buf[:])
+}
+
+// uuidText converts the bytes read from a database driver to the text of a
+// UUID: 16 bytes are a binary UUID, e.g. read from a BINARY(16) column or a
+// native uuid column in binary, other bytes are its text
+func uuidText(data []byte) string {
+	if len(data) != 16 {
+		return string(data)
+	}
+	var b [16]byte
+	copy(b[:], data)
+	return formatUUID(b)
+}
+
+// canonicalUUID returns the canonical representation of str, or str itself when it isn't a UUID
+func canonicalUUID(str string) string {
+	b, ok := uuidBytes(str)
+	if !ok {
+		return str
+	}
+	return formatUUID(b)
+}
+
+// uuidVersion returns the version of a UUID, 0 when str isn't a UUID
+func uuidVersion(str string) int {
+	b, _ := uuidBytes(str)
+	return int(b[6] >> 4)
+}
+
+// uuidVariant returns the variant of a UUID, VariantNCS when str isn't a UUID
+func uuidVariant(str string) UUIDVariant {
+	b, _ := uuidBytes(str)
+	switch {
+	case b[8]&0x80 == 0:
+		return VariantNCS
+	case b[8]&0x40 == 0:
+		return VariantRFC4122
+	case b[8]&0x20 == 0:
+		return VariantMicrosoft
+	default:
+		return VariantFuture
+	}
+}
+
+// newUUID sets the version and the RFC 4122 variant of b, and formats it
+func newUUID(b [16]byte, version byte) string {
+	b[6] = b[6]&0x0f | version<<4
+	b[8] = b[8]&0x3f | 0x80
+	return formatUUID(b)
+}
+
+// newNameUUID returns the name based UUID of name in namespace, hashed with h
+func newNameUUID(h hash.Hash, namespace UUID, name string, version byte) string {
+	ns := namespace.Bytes()
+	_, _ = h.Write(ns[:])
+	_, _ = io.WriteString(h, name)
+	var b [16]byte
+	copy(b[:], h.Sum(nil))
+	return newUUID(b, version)
+}
+
+// randomUUIDBytes returns 16 random bytes, it panics when the random generator of the system fails
+func randomUUIDBytes() [16]byte {
+	var b [16]byte
+	if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+		panic("strfmt: can't generate a random UUID: " + err.Error())
+	}
+	return b
+}
+
+// NewUUID4 returns a new random UUID, it panics when the random generator of
+// the system fails
+func NewUUID4() UUID4 {
+	return UUID4(newUUID(randomUUIDBytes(), 4))
+}
+
+// NewUUID3 returns the version 3 UUID of name in namespace, hashed with MD5
+func NewUUID3(namespace UUID, name string) UUID3 {
+	return UUID3(newNameUUID(md5.New(), namespace, name, 3))
+}
+
+// NewUUID5 returns the version 5 UUID of name in namespace, hashed with SHA-1,
+// e.g. NewUUID5(NamespaceDNS, "example.com")
+func NewUUID5(namespace UUID, name string) UUID5 {
+	return UUID5(newNameUUID(sha1.New(), namespace, name, 5))
+}
+
+// BinaryUUID writes a UUID of any version to databases as 16 raw bytes instead
+// of its text, for columns such as BINARY(16) in MySQL:
+//
+//	db.Exec("INSERT INTO users (id) VALUES (?)", strfmt.BinaryUUID{UUID: &id})
+//
+// The empty UUID and a nil UUID are written as NULL. UUIDs are read from both
+// binary and text values, and NULL is read as the empty UUID.
+type BinaryUUID struct {
+	// UUID points to a UUID of any version, e.g. a *UUID or a *UUID7
+	UUID interface {
+		Bytes() [16]byte
+		String() string
+		Scan(interface{}) error
+	}
+}
+
+// Value converts the UUID to 16 bytes ready to be written to a database
+func (b BinaryUUID) Value() (driver.Value, error) {
+	if b.isNil() {
+		return nil, nil
+	}
+	str := b.UUID.String()
+	if str == "" {
+		return nil, nil
+	}
+	if err := ValidateUUID(str); err != nil {
+		return nil, err
+	}
+	u := b.UUID.Bytes()
+	return u[:], nil
+}
+
+// Scan reads the UUID from a binary or text value of a database driver
+func (b BinaryUUID) Scan(raw interface{}) error {
+	if b.isNil() {
+		return errors.New("cannot sql.Scan() strfmt.BinaryUUID into a nil UUID")
+	}
+	switch v := raw.(type) {
+	case []byte, string:
+		return b.UUID.Scan(v)
+	case nil:
+		return b.UUID.Scan("")
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.BinaryUUID from: %#v", v)
+	}
+}
+
+// isNil reports whether the UUID is nil, or a nil pointer
+func (b BinaryUUID) isNil() bool {
+	if b.UUID == nil {
+		return true
+	}
+	v := reflect.ValueOf(b.UUID)
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// gregorianOffset is the number of 100 nanoseconds intervals from the start of
+// the gregorian calendar on October 15th 1582, the epoch of version 6 UUIDs, to
+// the Unix epoch
+const gregorianOffset = 122192928000000000
+
+// NewUUID6 returns a new version 6 UUID, made of the current time to the 100
+// nanoseconds and of a random clock sequence and node. It panics when the random
+// generator of the system fails.
+func NewUUID6() UUID6 {
+	return UUID6(newUUID(uuid6Bytes(time.Now()), 6))
+}
+
+// uuid6Bytes returns the bytes of a version 6 UUID generated at t
+func uuid6Bytes(t time.Time) [16]byte {
+	b := randomUUIDBytes()
+	ts := uint64(t.Unix()*1e7+int64(t.Nanosecond()/100)) + gregorianOffset
+	b[0], b[1], b[2], b[3] = byte(ts>>52), byte(ts>>44), byte(ts>>36), byte(ts>>28)
+	b[4], b[5] = byte(ts>>20), byte(ts>>12)
+	b[6], b[7] = byte(ts>>8)&0x0f, byte(ts)
+	return b
+}
+
+// uuid7Generator generates version 7 UUIDs which are strictly increasing, even
+// when several are generated within a millisecond
+type uuid7Generator struct {
+	sync.Mutex
+	now func() time.Time
+	// ms is the Unix time in milliseconds of the last UUID
+	ms int64
+	// seq is the 12 bits counter of the UUIDs generated within the millisecond
+	seq uint16
+}
+
+var uuid7s = &uuid7Generator{now: time.Now}
+
+// next returns the bytes of the next UUID
+func (g *uuid7Generator) next() [16]byte {
+	b := randomUUIDBytes()
+	g.Lock()
+	ms := g.now().UnixNano() / int64(time.Millisecond)
+	if ms > g.ms {
+		// the counter starts at a random value, leaving room to increment it
+		g.ms, g.seq = ms, (uint16(b[6])<<8|uint16(b[7]))&0x7ff
+	} else {
+		// within the same millisecond, or when the clock goes backwards
+		g.seq++
+		if g.seq > 0xfff {
+			g.ms, g.seq = g.ms+1, 0
+		}
+	}
+	ms, seq := g.ms, g.seq
+	g.Unlock()
+
+	b[0], b[1], b[2], b[3], b[4], b[5] = byte(ms>>40), byte(ms>>32), byte(ms>>24), byte(ms>>16), byte(ms>>8), byte(ms)
+	b[6], b[7] = byte(seq>>8), byte(seq)
+	return b
+}
+
+// NewUUID7 returns a new version 7 UUID, made of the current Unix time in
+// milliseconds, a counter and random bits. It panics when the random generator
+// of the system fails.
+//
+// The UUIDs generated by a process are strictly increasing, so they sort in
+// the order they were generated in: the counter is incremented for the UUIDs
+// generated within the same millisecond.
+func NewUUID7() UUID7 {
+	return UUID7(newUUID(uuid7s.next(), 7))
+}
+
+// NewUUID8 returns the version 8 UUID of custom data: the bits of the version
+// and of the variant are set, the other 122 bits are kept.
+func NewUUID8(data [16]byte) UUID8 {
+	return UUID8(newUUID(data, 8))
+}
+
+// Time returns the time the UUID was generated at, to the 100 nanoseconds in
+// UTC, the zero time when it isn't a valid UUID
+func (u UUID6) Time() time.Time {
+	b, ok := uuidBytes(string(u))
+	if !ok {
+		return time.Time{}
+	}
+	ts := uint64(b[0])<<52 | uint64(b[1])<<44 | uint64(b[2])<<36 | uint64(b[3])<<28 |
+		uint64(b[4])<<20 | uint64(b[5])<<12 | uint64(b[6]&0x0f)<<8 | uint64(b[7])
+	elapsed := int64(ts) - gregorianOffset
+	return time.Unix(elapsed/1e7, elapsed%1e7*100).UTC()
+}
+
+// Time returns the time the UUID was generated at, to the millisecond in UTC,
+// the zero time when it isn't a valid UUID
+func (u UUID7) Time() time.Time {
+	b, ok := uuidBytes(string(u))
+	if !ok {
+		return time.Time{}
+	}
+	ms := int64(b[0])<<40 | int64(b[1])<<32 | int64(b[2])<<24 | int64(b[3])<<16 | int64(b[4])<<8 | int64(b[5])
+	return time.Unix(ms/1000, ms%1000*int64(time.Millisecond)).UTC()
+}
@@ -0,0 +1,289 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUUID_Bytes(t *testing.T) {
+	want := [16]byte{0xa0, 0xee, 0xbc, 0x99, 0x9c, 0x0b, 0x4e, 0xf8, 0xbb, 0x6d, 0x6b, 0xb9, 0xbd, 0x38, 0x0a, 0x11}
+	for _, str := range []string{
+		"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11",
+		"A0EEBC99-9C0B-4EF8-BB6D-6BB9BD380A11",
+		"a0eebc999c0b4ef8bb6d6bb9bd380a11",
+		"A0eebc99-9c0b4ef8-bb6d6bb9bd380a11",
+	} {
+		assert.Equal(t, want, UUID(str).Bytes(), str)
+		assert.Equal(t, UUID("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"), UUID(str).Canonical(), str)
+		assert.Equal(t, UUID4("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"), UUID4(str).Canonical(), str)
+	}
+
+	assert.Equal(t, [16]byte{}, UUID("not a uuid").Bytes())
+	assert.Equal(t, UUID("not a uuid"), UUID("not a uuid").Canonical())
+	assert.Equal(t, 0, UUID("not a uuid").Version())
+}
+
+func TestUUID_VersionAndVariant(t *testing.T) {
+	testCases := []struct {
+		in      UUID
+		version int
+		variant UUIDVariant
+	}{
+		{"a8098c1a-f86e-11da-bd1a-00112444be1e", 1, VariantRFC4122},
+		{"6fa459ea-ee8a-3ca4-894e-db77e160355e", 3, VariantRFC4122},
+		{"a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", 4, VariantRFC4122},
+		{"886313e1-3b8a-5372-9b90-0c9aee199e5d", 5, VariantRFC4122},
+		{"00000000-0000-0000-0000-000000000000", 0, VariantNCS},
+		{"00000000-0000-0000-c000-000000000000", 0, VariantMicrosoft},
+		{"FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF", 15, VariantFuture},
+	}
+	for _, tc := range testCases {
+		assert.Equal(t, tc.version, tc.in.Version(), tc.in)
+		assert.Equal(t, tc.variant, tc.in.Variant(), tc.in)
+	}
+	assert.Equal(t, 3, UUID3("6fa459ea-ee8a-3ca4-894e-db77e160355e").Version())
+	assert.Equal(t, VariantRFC4122, UUID5("886313e1-3b8a-5372-9b90-0c9aee199e5d").Variant())
+}
+
+func TestNewUUID4(t *testing.T) {
+	u := NewUUID4()
+	assert.NoError(t, ValidateUUID4(string(u)))
+	assert.Equal(t, u, u.Canonical())
+	assert.Equal(t, 4, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+	assert.NotEqual(t, u, NewUUID4())
+}
+
+func TestNewUUID3AndUUID5(t *testing.T) {
+	// the examples of RFC 4122 errata and of the python uuid module
+	u3 := NewUUID3(NamespaceDNS, "www.example.com")
+	assert.Equal(t, UUID3("5df41881-3aed-3515-88a7-2f4a814cf09e"), u3)
+	assert.NoError(t, ValidateUUID3(string(u3)))
+
+	u5 := NewUUID5(NamespaceDNS, "www.example.com")
+	assert.Equal(t, UUID5("2ed6657d-e927-568b-95e1-2665a8aea6a2"), u5)
+	assert.NoError(t, ValidateUUID5(string(u5)))
+
+	// the namespace is read from its bytes
+	assert.Equal(t, u5, NewUUID5("6BA7B8109DAD11D180B400C04FD430C8", "www.example.com"))
+	assert.NotEqual(t, u5, NewUUID5(NamespaceURL, "www.example.com"))
+}
+
+func TestUUID_Time(t *testing.T) {
+	// the examples of RFC 9562 appendix A
+	want := time.Date(2022, time.February, 22, 19, 22, 22, 0, time.UTC)
+	assert.Equal(t, want, UUID6("1EC9414C-232A-6B00-B3C8-9F6BDECED846").Time())
+	assert.Equal(t, want, UUID7("017F22E2-79B0-7CC3-98C4-DC0C0C07398F").Time())
+	assert.True(t, UUID6("not a uuid").Time().IsZero())
+	assert.True(t, UUID7("not a uuid").Time().IsZero())
+}
+
+func TestNewUUID6(t *testing.T) {
+	at := time.Date(2022, time.February, 22, 19, 22, 22, 123456700, time.UTC)
+	u := UUID6(newUUID(uuid6Bytes(at), 6))
+	assert.Equal(t, at, u.Time())
+	assert.Equal(t, "1ec9414c-2458-6187", string(u)[:18])
+
+	u = NewUUID6()
+	assert.NoError(t, ValidateUUID6(string(u)))
+	assert.Equal(t, 6, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+	assert.WithinDuration(t, time.Now(), u.Time(), time.Second)
+}
+
+func TestNewUUID7(t *testing.T) {
+	u := NewUUID7()
+	assert.NoError(t, ValidateUUID7(string(u)))
+	assert.Equal(t, 7, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+	assert.WithinDuration(t, time.Now(), u.Time(), time.Second)
+}
+
+func TestUUID7Generator_monotonic(t *testing.T) {
+	now := time.Date(2022, time.February, 22, 19, 22, 22, 0, time.UTC)
+	g := &uuid7Generator{now: func() time.Time { return now }}
+
+	// more UUIDs than the counter holds within a millisecond, then the clock goes backwards
+	uuids := make([]string, 0, 6000)
+	for i := 0; i < 5000; i++ {
+		uuids = append(uuids, newUUID(g.next(), 7))
+	}
+	now = now.Add(-time.Second)
+	for i := 0; i < 1000; i++ {
+		uuids = append(uuids, newUUID(g.next(), 7))
+	}
+
+	assert.True(t, sort.StringsAreSorted(uuids))
+	for i := 1; i < len(uuids); i++ {
+		assert.NotEqual(t, uuids[i-1], uuids[i])
+	}
+	assert.Equal(t, now.Add(time.Second), UUID7(uuids[0]).Time())
+	assert.True(t, UUID7(uuids[len(uuids)-1]).Time().After(UUID7(uuids[0]).Time()))
+}
+
+func TestNewUUID8(t *testing.T) {
+	data := [16]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	u := NewUUID8(data)
+	assert.Equal(t, UUID8("ffffffff-ffff-8fff-bfff-ffffffffffff"), u)
+	assert.NoError(t, ValidateUUID8(string(u)))
+	assert.Equal(t, 8, u.Version())
+	assert.Equal(t, VariantRFC4122, u.Variant())
+	assert.Equal(t, u, UUID8("FFFFFFFFFFFF8FFFBFFFFFFFFFFFFFFF").Canonical())
+}
+
+// fakeDriver is an in-memory database/sql driver storing the arguments of the
+// INSERT statements as rows of a single table per data source name, which SELECT
+// statements return as they were stored, e.g. as raw bytes like a BINARY(16) column.
+type fakeDriver struct {
+	sync.Mutex
+	tables map[string][][]driver.Value
+}
+
+var uuidDriver = &fakeDriver{tables: make(map[string][][]driver.Value)}
+
+func init() {
+	sql.Register("strfmt-fake", uuidDriver)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d, table: name}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+	table  string
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, insert: strings.HasPrefix(query, "INSERT")}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return c, nil }
+
+func (c *fakeConn) Commit() error { return nil }
+
+func (c *fakeConn) Rollback() error { return nil }
+
+type fakeStmt struct {
+	conn   *fakeConn
+	insert bool
+}
+
+func (s *fakeStmt) Close() error { return nil }
+
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	d := s.conn.driver
+	d.Lock()
+	defer d.Unlock()
+	d.tables[s.conn.table] = append(d.tables[s.conn.table], args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	d := s.conn.driver
+	d.Lock()
+	defer d.Unlock()
+	return &fakeRows{rows: append([][]driver.Value(nil), d.tables[s.conn.table]...)}, nil
+}
+
+type fakeRows struct {
+	rows [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"id"} }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func TestUUID_sql(t *testing.T) {
+	db, err := sql.Open("strfmt-fake", "uuid")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer db.Close()
+
+	id := UUID7("017F22E2-79B0-7CC3-98C4-DC0C0C07398F")
+	// a text column, a BINARY(16) column, a NULL and a native uuid column read in binary
+	_, err = db.Exec("INSERT INTO t (id) VALUES (?)", id)
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO t (id) VALUES (?)", BinaryUUID{UUID: &id})
+	assert.NoError(t, err)
+	_, err = db.Exec("INSERT INTO t (id) VALUES (?)", BinaryUUID{UUID: new(UUID7)})
+	assert.NoError(t, err)
+	raw := id.Bytes()
+	_, err = db.Exec("INSERT INTO t (id) VALUES (?)", raw[:])
+	assert.NoError(t, err)
+
+	stored := uuidDriver.tables["uuid"]
+	assert.Equal(t, "017F22E2-79B0-7CC3-98C4-DC0C0C07398F", stored[0][0])
+	assert.Equal(t, raw[:], stored[1][0])
+	assert.Nil(t, stored[2][0])
+
+	rows, err := db.Query("SELECT id FROM t")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer rows.Close()
+	var got []UUID7
+	for rows.Next() {
+		var u UUID7
+		assert.NoError(t, rows.Scan(BinaryUUID{UUID: &u}))
+		got = append(got, u)
+	}
+	assert.NoError(t, rows.Err())
+	assert.Equal(t, []UUID7{id, id.Canonical(), "", id.Canonical()}, got)
+
+	// binary values are read without the wrapper too
+	var u UUID
+	assert.NoError(t, u.Scan(raw[:]))
+	assert.Equal(t, UUID("017f22e2-79b0-7cc3-98c4-dc0c0c07398f"), u)
+
+	bad := UUID("not a uuid")
+	_, err = db.Exec("INSERT INTO t (id) VALUES (?)", BinaryUUID{UUID: &bad})
+	assert.Error(t, err)
+	assert.Error(t, BinaryUUID{UUID: &u}.Scan(42))
+
+	// a nil UUID is written as NULL, and can't be read into
+	for _, nilUUID := range []BinaryUUID{{}, {UUID: (*UUID7)(nil)}} {
+		val, err := nilUUID.Value()
+		assert.NoError(t, err)
+		assert.Nil(t, val)
+		assert.Error(t, nilUUID.Scan(raw[:]))
+	}
+	_, err = db.Exec("INSERT INTO t (id) VALUES (?)", BinaryUUID{})
+	assert.NoError(t, err)
+}
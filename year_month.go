@@ -0,0 +1,427 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+)
+
+func init() {
+	ym := YearMonth{}
+	// register these formats in the default registry
+	AddWithInfo(Default, "year-month", &ym, ValidateYearMonth, FormatInfo{
+		Description:     "A month of a year, as YYYY-MM, or as MM/YY or MM/YYYY like the expiry of a card",
+		Pattern:         `^([0-9]{4}-(0[1-9]|1[0-2])|(0[1-9]|1[0-2])/([0-9]{2}|[0-9]{4}))$`,
+		Examples:        []string{"2024-05", "05/26", "05/2026"},
+		InvalidExamples: []string{"2024-13", "2024-5", "5/26", "2024-05-01"},
+	})
+	y := Year(0)
+	AddWithInfo(Default, "year", &y, ValidateYear, FormatInfo{
+		Description:     "A year, as 4 digits",
+		Pattern:         `^[0-9]{4}$`,
+		MaxLength:       4,
+		Examples:        []string{"2024", "0001"},
+		InvalidExamples: []string{"24", "-2024", "2024-05"},
+	})
+}
+
+const (
+	// YearMonthLayout describes a month of a year, e.g. 2024-05, where 0 stands for a digit
+	YearMonthLayout = "0000-00"
+	// CardExpiryLayout describes the expiry of a card, e.g. 05/26, where 0 stands for a digit
+	CardExpiryLayout = "00/00"
+	// cardExpiryLongLayout describes the expiry of a card with a 4 digits year, e.g. 05/2026
+	cardExpiryLongLayout = "00/0000"
+	// YearLayout describes a year, e.g. 2024, where 0 stands for a digit
+	YearLayout = "0000"
+)
+
+// IsYearMonth returns true when the string is a valid year-month
+func IsYearMonth(str string) bool {
+	return ValidateYearMonth(str) == nil
+}
+
+// ValidateYearMonth returns a *FormatError when the string is not a valid year-month
+func ValidateYearMonth(str string) error {
+	_, err := ParseYearMonth(str)
+	return err
+}
+
+// YearMonth represents a month of a year, such as a billing period or the expiry of a card.
+//
+// The zero value is written as an empty string, or NULL in a database, and read back from it.
+//
+// swagger:strfmt year-month
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// ParseYearMonth parses a month of a year written as YYYY-MM, e.g. 2024-05, or as
+// MM/YY or MM/YYYY like the expiry of a card, e.g. 05/26, where YY is a year of
+// the 21st century.
+func ParseYearMonth(str string) (YearMonth, error) {
+	const name = "year-month"
+	layout, year, month := YearMonthLayout, 0, 5
+	switch {
+	case strings.IndexByte(str, '/') >= 0 && len(str) > len(CardExpiryLayout):
+		layout, year, month = cardExpiryLongLayout, 3, 0
+	case strings.IndexByte(str, '/') >= 0:
+		layout, year, month = CardExpiryLayout, 3, 0
+	}
+	if err := checkDigitLayout(name, str, layout); err != nil {
+		return YearMonth{}, err
+	}
+
+	ym := YearMonth{Month: time.Month(twoDigits(str, month))}
+	if ym.Month < time.January || ym.Month > time.December {
+		return YearMonth{}, newFormatError(name, str, month, ReasonOutOfRange)
+	}
+	if layout == CardExpiryLayout {
+		ym.Year = 2000 + twoDigits(str, year)
+	} else {
+		ym.Year = fourDigits(str, year)
+	}
+	return ym, nil
+}
+
+// YearMonthOf returns the month of the date d
+func YearMonthOf(d Date) YearMonth {
+	year, month, _ := time.Time(d).Date()
+	return YearMonth{Year: year, Month: month}
+}
+
+// FirstDay returns the first day of the month
+func (ym YearMonth) FirstDay() Date {
+	return NewDate(ym.Year, ym.Month, 1)
+}
+
+// LastDay returns the last day of the month
+func (ym YearMonth) LastDay() Date {
+	return NewDate(ym.Year, ym.Month+1, 0)
+}
+
+// AddMonths returns the month n months after ym, or before when n is negative
+func (ym YearMonth) AddMonths(n int) YearMonth {
+	return YearMonthOf(NewDate(ym.Year, ym.Month+time.Month(n), 1))
+}
+
+// Before reports whether ym is a month before o
+func (ym YearMonth) Before(o YearMonth) bool {
+	return ym.Compare(o) < 0
+}
+
+// After reports whether ym is a month after o
+func (ym YearMonth) After(o YearMonth) bool {
+	return ym.Compare(o) > 0
+}
+
+// Compare returns -1 if ym is before o, +1 if ym is after o and 0 if they are the same month
+func (ym YearMonth) Compare(o YearMonth) int {
+	switch {
+	case ym.Year < o.Year || ym.Year == o.Year && ym.Month < o.Month:
+		return -1
+	case ym == o:
+		return 0
+	default:
+		return +1
+	}
+}
+
+// IsZero reports whether ym is the zero value, which is not a valid month
+func (ym YearMonth) IsZero() bool {
+	return ym == YearMonth{}
+}
+
+// String converts this month to YYYY-MM, the zero value to the empty string
+func (ym YearMonth) String() string {
+	if ym.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d", ym.Year, int(ym.Month))
+}
+
+// MarshalText serializes this month to YYYY-MM
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return []byte(ym.String()), nil
+}
+
+// UnmarshalText parses a text representation into a month, the empty text into the zero value
+func (ym *YearMonth) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*ym = YearMonth{}
+		return nil
+	}
+	m, err := ParseYearMonth(string(text))
+	if err != nil {
+		return err
+	}
+	*ym = m
+	return nil
+}
+
+// Scan scans a YearMonth value from database driver type, the month of a date
+// being read from a time or from a date written as YYYY-MM-DD, e.g. in a DATE column.
+func (ym *YearMonth) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return ym.scanText(string(v))
+	case string:
+		return ym.scanText(v)
+	case time.Time:
+		*ym = YearMonthOf(Date(v))
+	case nil:
+		*ym = YearMonth{}
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.YearMonth from: %#v", v)
+	}
+
+	return nil
+}
+
+// scanText reads a month written as YYYY-MM or the month of a date written as YYYY-MM-DD
+func (ym *YearMonth) scanText(str string) error {
+	if len(str) == len(RFC3339FullDate) {
+		d, err := time.Parse(RFC3339FullDate, str)
+		if err != nil {
+			return err
+		}
+		*ym = YearMonthOf(Date(d))
+		return nil
+	}
+	return ym.UnmarshalText([]byte(str))
+}
+
+// Value converts YearMonth to the first day of the month, written like a Date
+// so that it may be stored in a DATE column, the zero value to NULL.
+func (ym YearMonth) Value() (driver.Value, error) {
+	if ym.IsZero() {
+		return nil, nil
+	}
+	return ym.FirstDay().Value()
+}
+
+// MarshalJSON returns the YearMonth as JSON
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	ym.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the YearMonth to a easyjson.Writer
+func (ym YearMonth) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(ym.String())
+}
+
+// UnmarshalJSON sets the YearMonth from JSON
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	ym.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the YearMonth from a easyjson.Lexer
+func (ym *YearMonth) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		if err := ym.UnmarshalText([]byte(data)); err != nil {
+			in.AddError(err)
+		}
+	}
+}
+
+// GetBSON returns the YearMonth as a bson.M{} map.
+func (ym *YearMonth) GetBSON() (interface{}, error) {
+	return bson.M{"data": ym.String()}, nil
+}
+
+// SetBSON sets the YearMonth from raw bson data
+func (ym *YearMonth) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return ym.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as YearMonth")
+}
+
+// IsYear returns true when the string is a valid year
+func IsYear(str string) bool {
+	return ValidateYear(str) == nil
+}
+
+// ValidateYear returns a *FormatError when the string is not a valid year
+func ValidateYear(str string) error {
+	_, err := ParseYear(str)
+	return err
+}
+
+// Year represents a year, written with 4 digits
+//
+// swagger:strfmt year
+type Year int
+
+// ParseYear parses a year written with 4 digits, e.g. 2024
+func ParseYear(str string) (Year, error) {
+	if err := checkDigitLayout("year", str, YearLayout); err != nil {
+		return 0, err
+	}
+	return Year(fourDigits(str, 0)), nil
+}
+
+// FirstDay returns the first day of the year
+func (y Year) FirstDay() Date {
+	return NewDate(int(y), time.January, 1)
+}
+
+// LastDay returns the last day of the year
+func (y Year) LastDay() Date {
+	return NewDate(int(y), time.December, 31)
+}
+
+// IsLeap reports whether the year has 366 days
+func (y Year) IsLeap() bool {
+	return y%4 == 0 && (y%100 != 0 || y%400 == 0)
+}
+
+// Before reports whether y is a year before o
+func (y Year) Before(o Year) bool {
+	return y < o
+}
+
+// After reports whether y is a year after o
+func (y Year) After(o Year) bool {
+	return y > o
+}
+
+// Compare returns -1 if y is before o, +1 if y is after o and 0 if they are the same year
+func (y Year) Compare(o Year) int {
+	switch {
+	case y < o:
+		return -1
+	case y > o:
+		return +1
+	default:
+		return 0
+	}
+}
+
+// String converts this year to 4 digits
+func (y Year) String() string {
+	return fmt.Sprintf("%04d", int(y))
+}
+
+// MarshalText serializes this year to 4 digits
+func (y Year) MarshalText() ([]byte, error) {
+	return []byte(y.String()), nil
+}
+
+// UnmarshalText parses a text representation into a year
+func (y *Year) UnmarshalText(text []byte) error {
+	yy, err := ParseYear(string(text))
+	if err != nil {
+		return err
+	}
+	*y = yy
+	return nil
+}
+
+// Scan scans a Year value from database driver type, such as the integer of
+// a YEAR column or the year of a date.
+func (y *Year) Scan(raw interface{}) error {
+	switch v := raw.(type) {
+	case []byte:
+		return y.UnmarshalText(v)
+	case string:
+		return y.UnmarshalText([]byte(v))
+	case int64:
+		*y = Year(v)
+	case time.Time:
+		*y = Year(v.Year())
+	case nil:
+		*y = Year(0)
+	default:
+		return fmt.Errorf("cannot sql.Scan() strfmt.Year from: %#v", v)
+	}
+
+	return nil
+}
+
+// Value converts Year to an integer ready to be written to a database.
+func (y Year) Value() (driver.Value, error) {
+	return driver.Value(int64(y)), nil
+}
+
+// MarshalJSON returns the Year as JSON
+func (y Year) MarshalJSON() ([]byte, error) {
+	var w jwriter.Writer
+	y.MarshalEasyJSON(&w)
+	return w.BuildBytes()
+}
+
+// MarshalEasyJSON writes the Year to a easyjson.Writer
+func (y Year) MarshalEasyJSON(w *jwriter.Writer) {
+	w.String(y.String())
+}
+
+// UnmarshalJSON sets the Year from JSON
+func (y *Year) UnmarshalJSON(data []byte) error {
+	l := jlexer.Lexer{Data: data}
+	y.UnmarshalEasyJSON(&l)
+	return l.Error()
+}
+
+// UnmarshalEasyJSON sets the Year from a easyjson.Lexer
+func (y *Year) UnmarshalEasyJSON(in *jlexer.Lexer) {
+	if data := in.String(); in.Ok() {
+		yy, err := ParseYear(data)
+		if err != nil {
+			in.AddError(err)
+			return
+		}
+		*y = yy
+	}
+}
+
+// GetBSON returns the Year as a bson.M{} map.
+func (y *Year) GetBSON() (interface{}, error) {
+	return bson.M{"data": y.String()}, nil
+}
+
+// SetBSON sets the Year from raw bson data
+func (y *Year) SetBSON(raw bson.Raw) error {
+	var m bson.M
+	if err := raw.Unmarshal(&m); err != nil {
+		return err
+	}
+
+	if data, ok := m["data"].(string); ok {
+		return y.UnmarshalText([]byte(data))
+	}
+
+	return errors.New("couldn't unmarshal bson raw value as Year")
+}
@@ -0,0 +1,225 @@
+// Copyright 2015 go-swagger maintainers
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strfmt
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseYearMonth(t *testing.T) {
+	for in, want := range map[string]YearMonth{
+		"2024-05": {2024, time.May},
+		"0001-12": {1, time.December},
+		"05/26":   {2026, time.May},
+		"12/2031": {2031, time.December},
+	} {
+		ym, err := ParseYearMonth(in)
+		if assert.NoError(t, err, in) {
+			assert.Equal(t, want, ym, in)
+		}
+	}
+
+	assertFormatError(t, ValidateYearMonth("2024-13"), "year-month", 5, ReasonOutOfRange)
+	assertFormatError(t, ValidateYearMonth("2024-00"), "year-month", 5, ReasonOutOfRange)
+	assertFormatError(t, ValidateYearMonth("2024-5"), "year-month", 6, ReasonInvalidLength)
+	assertFormatError(t, ValidateYearMonth("2024-05-01"), "year-month", 7, ReasonInvalidLength)
+	assertFormatError(t, ValidateYearMonth("13/26"), "year-month", 0, ReasonOutOfRange)
+	assertFormatError(t, ValidateYearMonth("5/26"), "year-month", 1, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateYearMonth("05/2x"), "year-month", 4, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateYearMonth("05/20260"), "year-month", 7, ReasonInvalidLength)
+	assert.False(t, IsYearMonth(""))
+}
+
+func TestYearMonth_calendar(t *testing.T) {
+	feb := YearMonth{2024, time.February}
+	assert.Equal(t, "2024-02", feb.String())
+	assert.Equal(t, "2024-02-01", feb.FirstDay().String())
+	assert.Equal(t, "2024-02-29", feb.LastDay().String())
+	assert.Equal(t, "2023-02-28", YearMonth{2023, time.February}.LastDay().String())
+	assert.Equal(t, "2024-12-31", YearMonth{2024, time.December}.LastDay().String())
+	assert.Equal(t, YearMonth{2025, time.January}, feb.AddMonths(11))
+	assert.Equal(t, YearMonth{2023, time.December}, feb.AddMonths(-2))
+	assert.Equal(t, feb, YearMonthOf(NewDate(2024, time.February, 29)))
+
+	mar := YearMonth{2024, time.March}
+	next := YearMonth{2025, time.January}
+	assert.True(t, feb.Before(mar))
+	assert.True(t, mar.Before(next))
+	assert.True(t, next.After(feb))
+	assert.False(t, feb.After(feb))
+	assert.Equal(t, -1, feb.Compare(mar))
+	assert.Equal(t, 0, feb.Compare(feb))
+	assert.Equal(t, 1, next.Compare(mar))
+}
+
+func TestYearMonth_marshaling(t *testing.T) {
+	ym := YearMonth{2026, time.May}
+	const str = "2026-05"
+
+	txt, err := ym.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, str, string(txt))
+	var back YearMonth
+	assert.NoError(t, back.UnmarshalText([]byte("05/26")))
+	assert.Equal(t, ym, back)
+
+	js, err := ym.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"`+str+`"`, string(js))
+	back = YearMonth{}
+	assert.NoError(t, back.UnmarshalJSON(js))
+	assert.Equal(t, ym, back)
+	assert.Error(t, back.UnmarshalJSON([]byte(`"yada"`)))
+	assert.Equal(t, ym, back)
+
+	// written as the first day of the month, like a Date, for DATE columns
+	val, err := ym.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-05-01", val)
+
+	back = YearMonth{}
+	assert.NoError(t, back.Scan(val))
+	assert.Equal(t, ym, back)
+	back = YearMonth{}
+	assert.NoError(t, back.Scan([]byte(str)))
+	assert.Equal(t, ym, back)
+	assert.NoError(t, back.Scan([]byte("2026-05-17")))
+	assert.Equal(t, ym, back)
+	assert.Error(t, back.Scan("2026-13-01"))
+	assert.NoError(t, back.Scan(time.Date(2026, 5, 17, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, ym, back)
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, YearMonth{}, back)
+	assert.Error(t, back.Scan(42))
+
+	bsonData, err := bson.Marshal(&ym)
+	assert.NoError(t, err)
+	back = YearMonth{}
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, ym, back)
+
+	v, err := Default.Parse("yearmonth", "05/26")
+	assert.NoError(t, err)
+	assert.Equal(t, &ym, v)
+}
+
+func TestYearMonth_zero(t *testing.T) {
+	type billing struct {
+		Period YearMonth `json:"period"`
+	}
+	var zero YearMonth
+	assert.True(t, zero.IsZero())
+	assert.False(t, YearMonth{2026, time.May}.IsZero())
+	assert.Equal(t, "", zero.String())
+
+	js, err := json.Marshal(billing{})
+	assert.NoError(t, err)
+	assert.Equal(t, `{"period":""}`, string(js))
+	back := billing{Period: YearMonth{2026, time.May}}
+	assert.NoError(t, json.Unmarshal(js, &back))
+	assert.Equal(t, billing{}, back)
+
+	txt, err := zero.MarshalText()
+	assert.NoError(t, err)
+	back.Period = YearMonth{2026, time.May}
+	assert.NoError(t, back.Period.UnmarshalText(txt))
+	assert.True(t, back.Period.IsZero())
+
+	val, err := zero.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, val)
+	back.Period = YearMonth{2026, time.May}
+	assert.NoError(t, back.Period.Scan(val))
+	assert.True(t, back.Period.IsZero())
+
+	bsonData, err := bson.Marshal(&zero)
+	assert.NoError(t, err)
+	back.Period = YearMonth{2026, time.May}
+	assert.NoError(t, bson.Unmarshal(bsonData, &back.Period))
+	assert.True(t, back.Period.IsZero())
+
+	// the empty string isn't a valid year-month though
+	assert.Error(t, ValidateYearMonth(""))
+}
+
+func TestYear(t *testing.T) {
+	y, err := ParseYear("2024")
+	assert.NoError(t, err)
+	assert.Equal(t, Year(2024), y)
+	assert.Equal(t, "2024", y.String())
+	assert.Equal(t, "0042", Year(42).String())
+
+	assertFormatError(t, ValidateYear("24"), "year", 2, ReasonInvalidLength)
+	assertFormatError(t, ValidateYear("-2024"), "year", 0, ReasonInvalidCharacter)
+	assertFormatError(t, ValidateYear("2024-05"), "year", 4, ReasonInvalidLength)
+
+	assert.Equal(t, "2024-01-01", y.FirstDay().String())
+	assert.Equal(t, "2024-12-31", y.LastDay().String())
+	assert.True(t, y.IsLeap())
+	assert.True(t, Year(2000).IsLeap())
+	assert.False(t, Year(1900).IsLeap())
+	assert.False(t, Year(2023).IsLeap())
+	assert.True(t, Year(2023).Before(y))
+	assert.True(t, y.After(2023))
+	assert.Equal(t, -1, Year(2023).Compare(y))
+	assert.Equal(t, 0, y.Compare(2024))
+	assert.Equal(t, 1, y.Compare(2023))
+}
+
+func TestYear_marshaling(t *testing.T) {
+	y := Year(2024)
+
+	txt, err := y.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024", string(txt))
+	var back Year
+	assert.NoError(t, back.UnmarshalText(txt))
+	assert.Equal(t, y, back)
+
+	js, err := y.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, `"2024"`, string(js))
+	back = 0
+	assert.NoError(t, back.UnmarshalJSON(js))
+	assert.Equal(t, y, back)
+	assert.Error(t, back.UnmarshalJSON([]byte(`"24"`)))
+
+	val, err := y.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2024), val)
+
+	back = 0
+	assert.NoError(t, back.Scan(int64(2024)))
+	assert.Equal(t, y, back)
+	assert.NoError(t, back.Scan("2024"))
+	assert.Equal(t, y, back)
+	assert.NoError(t, back.Scan(time.Date(2024, 5, 17, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, y, back)
+	assert.NoError(t, back.Scan(nil))
+	assert.Equal(t, Year(0), back)
+	assert.Error(t, back.Scan(true))
+
+	bsonData, err := bson.Marshal(&y)
+	assert.NoError(t, err)
+	back = 0
+	assert.NoError(t, bson.Unmarshal(bsonData, &back))
+	assert.Equal(t, y, back)
+}